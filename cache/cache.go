@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+type (
+	// Cache is a bounded key-value store that evicts entries once it is full
+	Cache[K comparable, V any] interface {
+
+		// Get returns the value cached for the key, counting a hit or a miss
+		// Example:
+		//
+		//	c := cache.NewLRU[string, int](2)
+		//	c.Put("a", 1)
+		//	c.Get("a")
+		//
+		// Output: Optional[1]
+		Get(key K) optional.Optional[V]
+
+		// Put caches the value using the default time to live, evicting an entry if the cache is full
+		Put(key K, value V)
+
+		// PutWithTTL caches the value for the given duration, a non-positive ttl meaning it never expires
+		PutWithTTL(key K, value V, ttl time.Duration)
+
+		// GetOrPut returns the cached value or caches and returns the one built by defaultFn
+		// Example:
+		//
+		//	c := cache.NewLRU[string, int](2)
+		//	c.GetOrPut("a", func() int { return 1 })
+		//
+		// Output: 1
+		GetOrPut(key K, defaultFn func() V) V
+
+		// ContainsKey checks if an unexpired value is cached for the key without counting an access
+		ContainsKey(key K) bool
+
+		// Remove drops the key from the cache
+		Remove(key K)
+
+		// Clear drops every entry from the cache
+		Clear()
+
+		// Len returns the number of cached entries, including expired ones not yet purged
+		Len() int
+
+		// Capacity returns the maximum number of entries the cache holds
+		Capacity() int
+
+		// Stats returns the hit, miss and eviction counters
+		Stats() Stats
+	}
+
+	// Clock returns the current time. Inject one with WithClock to control expiration in tests.
+	Clock func() time.Time
+
+	// EvictionCallback is called with every entry leaving the cache and the reason it left
+	EvictionCallback[K comparable, V any] func(key K, value V, reason EvictionReason)
+
+	// Option configures a cache at construction time
+	Option[K comparable, V any] func(*config[K, V])
+
+	// EvictionReason tells why an entry left the cache
+	EvictionReason int
+
+	// Stats holds the counters of a cache
+	Stats struct {
+		Hits        uint64
+		Misses      uint64
+		Evictions   uint64
+		Expirations uint64
+	}
+
+	config[K comparable, V any] struct {
+		clock   Clock
+		ttl     time.Duration
+		onEvict EvictionCallback[K, V]
+	}
+
+	entry[V any] struct {
+		value     V
+		expiresAt time.Time
+	}
+)
+
+const (
+	// Capacity means the entry was evicted to make room for a new one
+	Capacity EvictionReason = iota
+	// Expired means the entry outlived its time to live
+	Expired
+	// Removed means the entry was removed explicitly with Remove or Clear
+	Removed
+)
+
+// WithClock sets the clock used to expire entries, time.Now by default
+func WithClock[K comparable, V any](clock Clock) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.clock = clock
+	}
+}
+
+// WithTTL sets the time to live used by Put and GetOrPut, entries never expire by default
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithOnEvict sets a callback receiving every entry that leaves the cache
+func WithOnEvict[K comparable, V any](callback EvictionCallback[K, V]) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = callback
+	}
+}
+
+// HitRatio returns the fraction of lookups that were hits, or 0 when there were none
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+func (r EvictionReason) String() string {
+	switch r {
+	case Capacity:
+		return "capacity"
+	case Expired:
+		return "expired"
+	case Removed:
+		return "removed"
+	}
+	return "unknown"
+}
+
+func newConfig[K comparable, V any](capacity int, options []Option[K, V]) config[K, V] {
+	if capacity <= 0 {
+		panic("cache capacity must be positive")
+	}
+	c := config[K, V]{clock: time.Now}
+	for _, option := range options {
+		option(&c)
+	}
+	return c
+}
+
+func (c config[K, V]) newEntry(value V, ttl time.Duration) entry[V] {
+	e := entry[V]{value: value}
+	if ttl > 0 {
+		e.expiresAt = c.clock().Add(ttl)
+	}
+	return e
+}
+
+func (c config[K, V]) expired(e entry[V]) bool {
+	return !e.expiresAt.IsZero() && !c.clock().Before(e.expiresAt)
+}
+
+func (c config[K, V]) evicted(key K, value V, reason EvictionReason, stats *Stats) {
+	switch reason {
+	case Capacity:
+		stats.Evictions++
+	case Expired:
+		stats.Expirations++
+	}
+	if c.onEvict != nil {
+		c.onEvict(key, value, reason)
+	}
+}
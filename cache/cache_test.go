@@ -0,0 +1,151 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/cache"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+var (
+	_ cache.Cache[string, int] = (*cache.LRU[string, int])(nil)
+	_ cache.Cache[string, int] = (*cache.LFU[string, int])(nil)
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+type eviction struct {
+	key    string
+	value  int
+	reason cache.EvictionReason
+}
+
+func recordEvictions(evictions *[]eviction) cache.Option[string, int] {
+	return cache.WithOnEvict(func(key string, value int, reason cache.EvictionReason) {
+		*evictions = append(*evictions, eviction{key, value, reason})
+	})
+}
+
+func TestStatsHitRatio(t *testing.T) {
+	assert.Equal(t, 0.0, cache.Stats{}.HitRatio())
+	assert.Equal(t, 0.75, cache.Stats{Hits: 3, Misses: 1}.HitRatio())
+}
+
+func TestEvictionReasonString(t *testing.T) {
+	assert.Equal(t, "capacity", cache.Capacity.String())
+	assert.Equal(t, "expired", cache.Expired.String())
+	assert.Equal(t, "removed", cache.Removed.String())
+	assert.Equal(t, "unknown", cache.EvictionReason(42).String())
+}
+
+func TestCacheContract(t *testing.T) {
+	constructors := map[string]func(int, ...cache.Option[string, int]) cache.Cache[string, int]{
+		"LRU": func(capacity int, options ...cache.Option[string, int]) cache.Cache[string, int] {
+			return cache.NewLRU(capacity, options...)
+		},
+		"LFU": func(capacity int, options ...cache.Option[string, int]) cache.Cache[string, int] {
+			return cache.NewLFU(capacity, options...)
+		},
+		"Synchronized": func(capacity int, options ...cache.Option[string, int]) cache.Cache[string, int] {
+			return cache.Synchronized[string, int](cache.NewLRU(capacity, options...))
+		},
+	}
+
+	for name, newCache := range constructors {
+		t.Run(name, func(t *testing.T) {
+			t.Run("gets put values and counts hits and misses", func(t *testing.T) {
+				c := newCache(2)
+				c.Put("a", 1)
+
+				assert.Equal(t, 1, c.Get("a").GetValue())
+				assert.True(t, c.Get("b").IsEmpty())
+				assert.Equal(t, cache.Stats{Hits: 1, Misses: 1}, c.Stats())
+				assert.Equal(t, 2, c.Capacity())
+			})
+
+			t.Run("never exceeds capacity", func(t *testing.T) {
+				var evictions []eviction
+				c := newCache(2, recordEvictions(&evictions))
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+
+				assert.Equal(t, 2, c.Len())
+				assert.Equal(t, []eviction{{"a", 1, cache.Capacity}}, evictions)
+				assert.Equal(t, uint64(1), c.Stats().Evictions)
+			})
+
+			t.Run("builds missing values once with GetOrPut", func(t *testing.T) {
+				c := newCache(2)
+				calls := 0
+				build := func() int {
+					calls++
+					return 42
+				}
+
+				assert.Equal(t, 42, c.GetOrPut("a", build))
+				assert.Equal(t, 42, c.GetOrPut("a", build))
+				assert.Equal(t, 1, calls)
+				assert.Equal(t, cache.Stats{Hits: 1, Misses: 1}, c.Stats())
+			})
+
+			t.Run("expires entries with the injected clock", func(t *testing.T) {
+				clock := &fakeClock{now: time.Unix(0, 0)}
+				var evictions []eviction
+				c := newCache(4,
+					cache.WithClock[string, int](clock.Now),
+					cache.WithTTL[string, int](time.Minute),
+					recordEvictions(&evictions),
+				)
+				c.Put("a", 1)
+				c.PutWithTTL("b", 2, time.Hour)
+				c.PutWithTTL("c", 3, 0)
+
+				clock.Advance(time.Minute)
+
+				assert.False(t, c.ContainsKey("a"))
+				assert.True(t, c.Get("a").IsEmpty())
+				assert.Equal(t, 2, c.Get("b").GetValue())
+				assert.Equal(t, 3, c.Get("c").GetValue())
+				assert.Equal(t, []eviction{{"a", 1, cache.Expired}}, evictions)
+				assert.Equal(t, uint64(1), c.Stats().Expirations)
+
+				clock.Advance(time.Hour)
+				assert.Equal(t, 7, c.GetOrPut("b", func() int { return 7 }))
+			})
+
+			t.Run("removes and clears entries", func(t *testing.T) {
+				var evictions []eviction
+				c := newCache(3, recordEvictions(&evictions))
+				c.Put("a", 1)
+				c.Put("b", 2)
+
+				c.Remove("a")
+				c.Remove("missing")
+				assert.False(t, c.ContainsKey("a"))
+				assert.Equal(t, []eviction{{"a", 1, cache.Removed}}, evictions)
+
+				c.Clear()
+				assert.Equal(t, 0, c.Len())
+				assert.Equal(t, 2, len(evictions))
+				assert.Equal(t, uint64(0), c.Stats().Evictions)
+			})
+		})
+	}
+}
+
+func TestNewCachePanicsOnInvalidCapacity(t *testing.T) {
+	assert.Panics(t, func() { cache.NewLRU[string, int](0) })
+	assert.Panics(t, func() { cache.NewLFU[string, int](-1) })
+}
@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+	"github.com/marlonbarreto-git/gollections/tomove/types"
+)
+
+// LFU is a cache that evicts the least frequently used entry when it is full,
+// breaking ties by evicting the least recently used among them.
+// It is not safe for concurrent use, wrap it with Synchronized for that.
+type LFU[K comparable, V any] struct {
+	entries      map[K]*lfuEntry[V]
+	buckets      map[int]*collection.LinkedMap[K, types.Empty]
+	minFrequency int
+	capacity     int
+	config       config[K, V]
+	stats        Stats
+}
+
+type lfuEntry[V any] struct {
+	entry[V]
+	frequency int
+}
+
+// NewLFU creates an LFU cache holding at most capacity entries. It panics if capacity is not positive.
+func NewLFU[K comparable, V any](capacity int, options ...Option[K, V]) *LFU[K, V] {
+	return &LFU[K, V]{
+		entries:  map[K]*lfuEntry[V]{},
+		buckets:  map[int]*collection.LinkedMap[K, types.Empty]{},
+		capacity: capacity,
+		config:   newConfig(capacity, options),
+	}
+}
+
+func (c *LFU[K, V]) Get(key K) optional.Optional[V] {
+	if e, ok := c.lookup(key); ok {
+		c.stats.Hits++
+		return optional.Of(e.value)
+	}
+	c.stats.Misses++
+	return optional.Empty[V]()
+}
+
+func (c *LFU[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.config.ttl)
+}
+
+func (c *LFU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if e, ok := c.entries[key]; ok {
+		e.entry = c.config.newEntry(value, ttl)
+		c.touch(key, e)
+		return
+	}
+	if len(c.entries) >= c.capacity {
+		c.evictLeastFrequent()
+	}
+	c.entries[key] = &lfuEntry[V]{entry: c.config.newEntry(value, ttl), frequency: 1}
+	c.bucket(1).Put(key, types.EmptyInstance)
+	c.minFrequency = 1
+}
+
+func (c *LFU[K, V]) GetOrPut(key K, defaultFn func() V) V {
+	if e, ok := c.lookup(key); ok {
+		c.stats.Hits++
+		return e.value
+	}
+	c.stats.Misses++
+	value := defaultFn()
+	c.Put(key, value)
+	return value
+}
+
+func (c *LFU[K, V]) ContainsKey(key K) bool {
+	e, ok := c.entries[key]
+	return ok && !c.config.expired(e.entry)
+}
+
+func (c *LFU[K, V]) Remove(key K) {
+	if e, ok := c.entries[key]; ok {
+		c.drop(key, e)
+		c.config.evicted(key, e.value, Removed, &c.stats)
+	}
+}
+
+func (c *LFU[K, V]) Clear() {
+	for key, e := range c.entries {
+		c.drop(key, e)
+		c.config.evicted(key, e.value, Removed, &c.stats)
+	}
+}
+
+func (c *LFU[K, V]) Len() int {
+	return len(c.entries)
+}
+
+func (c *LFU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+func (c *LFU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// Frequency returns how many times the key was put or read since it entered the cache, or 0 when absent
+func (c *LFU[K, V]) Frequency(key K) int {
+	if e, ok := c.entries[key]; ok {
+		return e.frequency
+	}
+	return 0
+}
+
+func (c *LFU[K, V]) lookup(key K) (*lfuEntry[V], bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.config.expired(e.entry) {
+		c.drop(key, e)
+		c.config.evicted(key, e.value, Expired, &c.stats)
+		return nil, false
+	}
+	c.touch(key, e)
+	return e, true
+}
+
+func (c *LFU[K, V]) touch(key K, e *lfuEntry[V]) {
+	c.unlink(key, e)
+	e.frequency++
+	c.bucket(e.frequency).Put(key, types.EmptyInstance)
+}
+
+func (c *LFU[K, V]) drop(key K, e *lfuEntry[V]) {
+	delete(c.entries, key)
+	c.unlink(key, e)
+}
+
+func (c *LFU[K, V]) unlink(key K, e *lfuEntry[V]) {
+	bucket := c.buckets[e.frequency]
+	bucket.Remove(key)
+	if bucket.IsEmpty() {
+		delete(c.buckets, e.frequency)
+		if c.minFrequency == e.frequency {
+			c.minFrequency++
+		}
+	}
+}
+
+func (c *LFU[K, V]) bucket(frequency int) *collection.LinkedMap[K, types.Empty] {
+	bucket, ok := c.buckets[frequency]
+	if !ok {
+		bucket = collection.NewLinkedMap[K, types.Empty]()
+		c.buckets[frequency] = bucket
+	}
+	return bucket
+}
+
+func (c *LFU[K, V]) evictLeastFrequent() {
+	bucket, ok := c.buckets[c.minFrequency]
+	if !ok {
+		// an explicit removal emptied the lowest bucket, so look the new minimum up
+		c.minFrequency = 0
+		for frequency := range c.buckets {
+			if c.minFrequency == 0 || frequency < c.minFrequency {
+				c.minFrequency = frequency
+			}
+		}
+		bucket = c.buckets[c.minFrequency]
+	}
+
+	key := bucket.FirstEntry().GetValue().First()
+	e := c.entries[key]
+	c.drop(key, e)
+	if c.config.expired(e.entry) {
+		c.config.evicted(key, e.value, Expired, &c.stats)
+	} else {
+		c.config.evicted(key, e.value, Capacity, &c.stats)
+	}
+}
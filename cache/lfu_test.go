@@ -0,0 +1,80 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/cache"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestLFUEvictsLeastFrequentlyUsed(t *testing.T) {
+	c := cache.NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("c", 3)
+
+	assert.True(t, c.ContainsKey("a"))
+	assert.False(t, c.ContainsKey("b"))
+	assert.Equal(t, 3, c.Frequency("a"))
+	assert.Equal(t, 1, c.Frequency("c"))
+	assert.Equal(t, 0, c.Frequency("b"))
+}
+
+func TestLFUBreaksTiesByRecency(t *testing.T) {
+	c := cache.NewLFU[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("b")
+	c.Get("c")
+	c.Put("d", 4)
+
+	assert.False(t, c.ContainsKey("a"))
+	assert.True(t, c.ContainsKey("b"))
+	assert.True(t, c.ContainsKey("c"))
+}
+
+func TestLFUPutCountsAsUse(t *testing.T) {
+	c := cache.NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 10)
+	c.Put("c", 3)
+
+	assert.Equal(t, 10, c.Get("a").GetValue())
+	assert.False(t, c.ContainsKey("b"))
+}
+
+func TestLFUEvictsAfterRemovingLowestFrequency(t *testing.T) {
+	c := cache.NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Get("a")
+	c.Get("a")
+	c.Put("b", 2)
+	c.Get("b")
+	c.Remove("a")
+	c.Put("c", 3)
+	c.Remove("c")
+	c.Put("d", 4)
+	c.Put("e", 5)
+
+	assert.True(t, c.ContainsKey("b"))
+	assert.False(t, c.ContainsKey("d"))
+	assert.True(t, c.ContainsKey("e"))
+}
+
+func TestLFUExpiredEntriesAreEvictedAsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evictions []eviction
+	c := cache.NewLFU(1, cache.WithClock[string, int](clock.Now), recordEvictions(&evictions))
+	c.PutWithTTL("a", 1, time.Second)
+	clock.Advance(2 * time.Second)
+	c.Put("b", 2)
+
+	assert.Equal(t, []eviction{{"a", 1, cache.Expired}}, evictions)
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+// LRU is a cache that evicts the least recently used entry when it is full.
+// It is not safe for concurrent use, wrap it with Synchronized for that.
+type LRU[K comparable, V any] struct {
+	entries  *collection.LinkedMap[K, entry[V]]
+	capacity int
+	config   config[K, V]
+	stats    Stats
+}
+
+// NewLRU creates an LRU cache holding at most capacity entries. It panics if capacity is not positive.
+func NewLRU[K comparable, V any](capacity int, options ...Option[K, V]) *LRU[K, V] {
+	return &LRU[K, V]{
+		entries:  collection.NewAccessOrderedLinkedMap[K, entry[V]](),
+		capacity: capacity,
+		config:   newConfig(capacity, options),
+	}
+}
+
+func (c *LRU[K, V]) Get(key K) optional.Optional[V] {
+	if e, ok := c.lookup(key); ok {
+		c.stats.Hits++
+		return optional.Of(e.value)
+	}
+	c.stats.Misses++
+	return optional.Empty[V]()
+}
+
+func (c *LRU[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.config.ttl)
+}
+
+func (c *LRU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if !c.entries.ContainsKey(key) && c.entries.Len() >= c.capacity {
+		c.evictEldest()
+	}
+	c.entries.Put(key, c.config.newEntry(value, ttl))
+}
+
+func (c *LRU[K, V]) GetOrPut(key K, defaultFn func() V) V {
+	if e, ok := c.lookup(key); ok {
+		c.stats.Hits++
+		return e.value
+	}
+	c.stats.Misses++
+	value := defaultFn()
+	c.Put(key, value)
+	return value
+}
+
+func (c *LRU[K, V]) ContainsKey(key K) bool {
+	e, ok := c.entries.Peek(key)
+	return ok && !c.config.expired(e)
+}
+
+func (c *LRU[K, V]) Remove(key K) {
+	if e, ok := c.entries.Peek(key); ok {
+		c.entries.Remove(key)
+		c.config.evicted(key, e.value, Removed, &c.stats)
+	}
+}
+
+func (c *LRU[K, V]) Clear() {
+	for key, e := range c.entries.Iter() {
+		c.entries.Remove(key)
+		c.config.evicted(key, e.value, Removed, &c.stats)
+	}
+}
+
+func (c *LRU[K, V]) Len() int {
+	return c.entries.Len()
+}
+
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+func (c *LRU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// Keys returns the cached keys from the least to the most recently used
+func (c *LRU[K, V]) Keys() collection.List[K] {
+	return c.entries.Keys()
+}
+
+func (c *LRU[K, V]) lookup(key K) (entry[V], bool) {
+	e, ok := c.entries.Lookup(key)
+	if ok && c.config.expired(e) {
+		c.entries.Remove(key)
+		c.config.evicted(key, e.value, Expired, &c.stats)
+		return e, false
+	}
+	return e, ok
+}
+
+func (c *LRU[K, V]) evictEldest() {
+	eldest := c.entries.FirstEntry().GetValue()
+	key, e := eldest.First(), eldest.Second()
+	c.entries.Remove(key)
+	if c.config.expired(e) {
+		c.config.evicted(key, e.value, Expired, &c.stats)
+	} else {
+		c.config.evicted(key, e.value, Capacity, &c.stats)
+	}
+}
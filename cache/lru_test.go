@@ -0,0 +1,55 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/cache"
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+
+	assert.False(t, c.ContainsKey("b"))
+	assert.Equal(t, collection.List[string]{"a", "c"}, c.Keys())
+}
+
+func TestLRUPutRefreshesRecency(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 10)
+	c.Put("c", 3)
+
+	assert.Equal(t, collection.List[string]{"a", "c"}, c.Keys())
+	assert.Equal(t, 10, c.Get("a").GetValue())
+}
+
+func TestLRUContainsKeyKeepsRecency(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.ContainsKey("a")
+	c.Put("c", 3)
+
+	assert.False(t, c.ContainsKey("a"))
+	assert.Equal(t, cache.Stats{Evictions: 1}, c.Stats())
+}
+
+func TestLRUEvictingExpiredEntryCountsAsExpiration(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evictions []eviction
+	c := cache.NewLRU(1, cache.WithClock[string, int](clock.Now), recordEvictions(&evictions))
+	c.PutWithTTL("a", 1, time.Second)
+	clock.Advance(time.Second)
+	c.Put("b", 2)
+
+	assert.Equal(t, []eviction{{"a", 1, cache.Expired}}, evictions)
+	assert.Equal(t, cache.Stats{Expirations: 1}, c.Stats())
+}
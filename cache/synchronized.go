@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+type synchronized[K comparable, V any] struct {
+	mutex sync.Mutex
+	cache Cache[K, V]
+}
+
+// Synchronized wraps a cache so it is safe for concurrent use.
+// Every call holds a single lock, so GetOrPut builds a missing value at most once per key;
+// defaultFn and eviction callbacks run under that lock and must not call back into the cache.
+func Synchronized[K comparable, V any](cache Cache[K, V]) Cache[K, V] {
+	return &synchronized[K, V]{cache: cache}
+}
+
+func (s *synchronized[K, V]) Get(key K) optional.Optional[V] {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Get(key)
+}
+
+func (s *synchronized[K, V]) Put(key K, value V) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cache.Put(key, value)
+}
+
+func (s *synchronized[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cache.PutWithTTL(key, value, ttl)
+}
+
+func (s *synchronized[K, V]) GetOrPut(key K, defaultFn func() V) V {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.GetOrPut(key, defaultFn)
+}
+
+func (s *synchronized[K, V]) ContainsKey(key K) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.ContainsKey(key)
+}
+
+func (s *synchronized[K, V]) Remove(key K) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cache.Remove(key)
+}
+
+func (s *synchronized[K, V]) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cache.Clear()
+}
+
+func (s *synchronized[K, V]) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Len()
+}
+
+func (s *synchronized[K, V]) Capacity() int {
+	return s.cache.Capacity()
+}
+
+func (s *synchronized[K, V]) Stats() Stats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Stats()
+}
@@ -0,0 +1,50 @@
+package cache_test
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/cache"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestSynchronizedConcurrentAccess(t *testing.T) {
+	c := cache.Synchronized[string, int](cache.NewLFU[string, int](64))
+	var builds atomic.Int32
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				key := strconv.Itoa(i % 32)
+				c.GetOrPut(key, func() int {
+					builds.Add(1)
+					return i % 32
+				})
+				c.Get(key)
+				c.ContainsKey(key)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(32), builds.Load())
+	assert.Equal(t, 32, c.Len())
+	assert.Equal(t, uint64(32), c.Stats().Misses)
+}
+
+func TestSynchronizedDelegates(t *testing.T) {
+	c := cache.Synchronized[string, int](cache.NewLRU[string, int](2))
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, 0)
+
+	assert.Equal(t, 2, c.Len())
+	c.Remove("a")
+	assert.False(t, c.ContainsKey("a"))
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+}
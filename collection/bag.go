@@ -0,0 +1,231 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Bag is a multiset: a set that remembers how many times each item was added.
+// Len counts every occurrence, DistinctLen counts each item once. The zero value is an empty bag.
+type Bag[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+func NewBag[T comparable]() *Bag[T] {
+	return &Bag[T]{counts: map[T]int{}}
+}
+
+func BagOf[T comparable](items ...T) *Bag[T] {
+	return BagFrom(items)
+}
+
+// BagFrom counts the occurrences of every item in the list
+func BagFrom[T comparable](list List[T]) *Bag[T] {
+	b := NewBag[T]()
+	for _, item := range list {
+		b.Add(item, 1)
+	}
+	return b
+}
+
+// Add adds n occurrences of the item and returns its new count. It panics if n is negative.
+func (b *Bag[T]) Add(item T, n int) int {
+	if n < 0 {
+		panic(fmt.Sprintf("negative occurrences: %d", n))
+	}
+	return b.SetCount(item, b.counts[item]+n)
+}
+
+// Remove removes up to n occurrences of the item and returns its new count. It panics if n is negative.
+func (b *Bag[T]) Remove(item T, n int) int {
+	if n < 0 {
+		panic(fmt.Sprintf("negative occurrences: %d", n))
+	}
+	return b.SetCount(item, max(b.counts[item]-n, 0))
+}
+
+// RemoveAll removes every occurrence of the item and returns how many there were
+func (b *Bag[T]) RemoveAll(item T) int {
+	count := b.counts[item]
+	b.SetCount(item, 0)
+	return count
+}
+
+// SetCount sets the number of occurrences of the item, removing it when count is zero, and returns count.
+// It panics if count is negative.
+func (b *Bag[T]) SetCount(item T, count int) int {
+	if count < 0 {
+		panic(fmt.Sprintf("negative count: %d", count))
+	}
+	if b.counts == nil {
+		b.counts = map[T]int{}
+	}
+
+	b.size += count - b.counts[item]
+	if count == 0 {
+		delete(b.counts, item)
+	} else {
+		b.counts[item] = count
+	}
+	return count
+}
+
+func (b *Bag[T]) Count(item T) int {
+	return b.counts[item]
+}
+
+func (b *Bag[T]) Contains(item T) bool {
+	return b.counts[item] > 0
+}
+
+// Len returns the total number of occurrences
+func (b *Bag[T]) Len() int {
+	return b.size
+}
+
+// DistinctLen returns the number of different items
+func (b *Bag[T]) DistinctLen() int {
+	return len(b.counts)
+}
+
+func (b *Bag[T]) IsEmpty() bool {
+	return b.size == 0
+}
+
+func (b *Bag[T]) Clear() {
+	clear(b.counts)
+	b.size = 0
+}
+
+// MostCommon returns the k items with the highest counts, most common first, or all of them if k is negative.
+// The order of items with equal counts is unspecified.
+func (b *Bag[T]) MostCommon(k int) []Pair[T, int] {
+	entries := b.Entries()
+	slices.SortFunc(entries, func(x, y Pair[T, int]) int {
+		return cmp.Compare(y.Second(), x.Second())
+	})
+	if k >= 0 && k < len(entries) {
+		entries = entries[:k]
+	}
+	return entries
+}
+
+// Union returns a bag where each item occurs as many times as in whichever bag holds more of it
+func (b *Bag[T]) Union(other *Bag[T]) *Bag[T] {
+	result := b.Copy()
+	for item, count := range other.counts {
+		if count > result.counts[item] {
+			result.SetCount(item, count)
+		}
+	}
+	return result
+}
+
+// Intersect returns a bag where each item occurs as many times as in whichever bag holds fewer of it
+func (b *Bag[T]) Intersect(other *Bag[T]) *Bag[T] {
+	result := NewBag[T]()
+	for item, count := range b.counts {
+		result.SetCount(item, min(count, other.counts[item]))
+	}
+	return result
+}
+
+// Subtract returns a bag with the occurrences in other taken away, never going below zero
+func (b *Bag[T]) Subtract(other *Bag[T]) *Bag[T] {
+	result := b.Copy()
+	for item, count := range other.counts {
+		result.Remove(item, count)
+	}
+	return result
+}
+
+// Sum returns a bag holding the occurrences of both bags added together
+func (b *Bag[T]) Sum(other *Bag[T]) *Bag[T] {
+	result := b.Copy()
+	for item, count := range other.counts {
+		result.Add(item, count)
+	}
+	return result
+}
+
+func (b *Bag[T]) Copy() *Bag[T] {
+	result := &Bag[T]{counts: make(map[T]int, len(b.counts)), size: b.size}
+	for item, count := range b.counts {
+		result.counts[item] = count
+	}
+	return result
+}
+
+// Iter returns an iterator over each distinct item and its count
+func (b *Bag[T]) Iter() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for item, count := range b.counts {
+			if !yield(item, count) {
+				return
+			}
+		}
+	}
+}
+
+func (b *Bag[T]) ForEach(fn func(item T, count int)) {
+	for item, count := range b.counts {
+		fn(item, count)
+	}
+}
+
+func (b *Bag[T]) Entries() []Pair[T, int] {
+	entries := make([]Pair[T, int], 0, len(b.counts))
+	for item, count := range b.counts {
+		entries = append(entries, PairOf(item, count))
+	}
+	return entries
+}
+
+// ToList returns every occurrence, repeating each item as many times as it was added
+func (b *Bag[T]) ToList() List[T] {
+	result := make(List[T], 0, b.size)
+	for item, count := range b.counts {
+		for i := 0; i < count; i++ {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ToSet returns the distinct items
+func (b *Bag[T]) ToSet() Set[T] {
+	result := make(Set[T], len(b.counts))
+	for item := range b.counts {
+		result.Add(item)
+	}
+	return result
+}
+
+func (b *Bag[T]) ToMap() MutableMap[T, int] {
+	result := make(MutableMap[T, int], len(b.counts))
+	for item, count := range b.counts {
+		result[item] = count
+	}
+	return result
+}
+
+func (b *Bag[T]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for item, count := range b.counts {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v: %d", item, count))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
@@ -0,0 +1,113 @@
+package collection_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/list"
+)
+
+func TestBagCounting(t *testing.T) {
+	t.Run("counts items from a list", func(t *testing.T) {
+		b := collection.BagFrom(list.Of("a", "b", "a", "c", "a"))
+
+		assert.Equal(t, 3, b.Count("a"))
+		assert.Equal(t, 0, b.Count("z"))
+		assert.Equal(t, 5, b.Len())
+		assert.Equal(t, 3, b.DistinctLen())
+		assert.True(t, b.Contains("c"))
+		assert.False(t, b.Contains("z"))
+	})
+
+	t.Run("adds and removes occurrences", func(t *testing.T) {
+		var b collection.Bag[string]
+
+		assert.Equal(t, 3, b.Add("a", 3))
+		assert.Equal(t, 1, b.Remove("a", 2))
+		assert.Equal(t, 0, b.Remove("a", 5))
+		assert.False(t, b.Contains("a"))
+		assert.Equal(t, 0, b.DistinctLen())
+		assert.True(t, b.IsEmpty())
+	})
+
+	t.Run("sets and clears counts", func(t *testing.T) {
+		b := collection.BagOf(1, 1, 2)
+
+		b.SetCount(1, 5)
+		assert.Equal(t, 6, b.Len())
+		assert.Equal(t, 5, b.RemoveAll(1))
+		assert.Equal(t, 1, b.Len())
+		b.Clear()
+		assert.True(t, b.IsEmpty())
+	})
+
+	t.Run("panics on negative counts", func(t *testing.T) {
+		b := collection.NewBag[int]()
+		assert.Panics(t, func() { b.Add(1, -1) })
+		assert.Panics(t, func() { b.Remove(1, -1) })
+		assert.Panics(t, func() { b.SetCount(1, -1) })
+	})
+}
+
+func TestBagMostCommon(t *testing.T) {
+	b := collection.BagOf("a", "b", "b", "c", "c", "c")
+
+	assert.Equal(t, []collection.Pair[string, int]{collection.PairOf("c", 3), collection.PairOf("b", 2)}, b.MostCommon(2))
+	assert.Equal(t, 3, len(b.MostCommon(-1)))
+	assert.Equal(t, 3, len(b.MostCommon(10)))
+	assert.Equal(t, 0, len(b.MostCommon(0)))
+}
+
+func TestBagOperations(t *testing.T) {
+	left := collection.BagOf("a", "a", "a", "b")
+	right := collection.BagOf("a", "b", "b", "c")
+
+	t.Run("Union keeps the highest counts", func(t *testing.T) {
+		assert.MapEqual(t, map[string]int{"a": 3, "b": 2, "c": 1}, left.Union(right).ToMap())
+		assert.Equal(t, 6, left.Union(right).Len())
+	})
+
+	t.Run("Intersect keeps the lowest counts", func(t *testing.T) {
+		assert.MapEqual(t, map[string]int{"a": 1, "b": 1}, left.Intersect(right).ToMap())
+		assert.Equal(t, 2, left.Intersect(right).Len())
+	})
+
+	t.Run("Subtract stops at zero", func(t *testing.T) {
+		assert.MapEqual(t, map[string]int{"a": 2}, left.Subtract(right).ToMap())
+	})
+
+	t.Run("Sum adds counts", func(t *testing.T) {
+		assert.MapEqual(t, map[string]int{"a": 4, "b": 3, "c": 1}, left.Sum(right).ToMap())
+		assert.Equal(t, 8, left.Sum(right).Len())
+	})
+
+	t.Run("leaves the operands untouched", func(t *testing.T) {
+		assert.MapEqual(t, map[string]int{"a": 3, "b": 1}, left.ToMap())
+		assert.MapEqual(t, map[string]int{"a": 1, "b": 2, "c": 1}, right.ToMap())
+	})
+}
+
+func TestBagConversions(t *testing.T) {
+	b := collection.BagOf("x", "y", "x")
+
+	items := b.ToList()
+	sort.Strings(items)
+	assert.Equal(t, collection.List[string]{"x", "x", "y"}, items)
+	assert.MapEqual(t, collection.Set[string]{"x": {}, "y": {}}, b.ToSet())
+	assert.Equal(t, 2, len(b.Entries()))
+	assert.Equal(t, "{x: 2}", collection.BagOf("x", "x").String())
+
+	total := 0
+	b.ForEach(func(_ string, count int) { total += count })
+	assert.Equal(t, 3, total)
+
+	for item, count := range b.Iter() {
+		assert.Equal(t, b.Count(item), count)
+	}
+
+	copied := b.Copy()
+	copied.Add("z", 1)
+	assert.False(t, b.Contains("z"))
+}
@@ -0,0 +1,165 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+var DuplicateValueError = errors.New("value already present")
+
+// BiMap is a map whose values are unique as well as its keys, so it can be looked up in both directions.
+// Inverse returns a view of the same entries with keys and values swapped; changes through either side show in both.
+// The zero value is an empty BiMap.
+type BiMap[K, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	inverse  *BiMap[V, K]
+}
+
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return new(BiMap[K, V]).init()
+}
+
+// BiMapOf creates a BiMap from the pairs, failing with DuplicateValueError if two keys share a value
+func BiMapOf[K, V comparable](pairs ...Pair[K, V]) (*BiMap[K, V], error) {
+	m := NewBiMap[K, V]()
+	for _, pair := range pairs {
+		if err := m.Put(pair.First(), pair.Second()); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *BiMap[K, V]) init() *BiMap[K, V] {
+	if m.forward == nil {
+		m.forward, m.backward = map[K]V{}, map[V]K{}
+		m.inverse = &BiMap[V, K]{forward: m.backward, backward: m.forward, inverse: m}
+	}
+	return m
+}
+
+// Put maps the key to the value, replacing the key's previous value.
+// It fails with DuplicateValueError, leaving the map unchanged, if another key already has the value.
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	m.init()
+	if owner, ok := m.backward[value]; ok && owner != key {
+		return fmt.Errorf("%w: %v is mapped from %v", DuplicateValueError, value, owner)
+	}
+	m.put(key, value)
+	return nil
+}
+
+// ForcePut maps the key to the value, first removing any other key that had the value
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	m.init()
+	if owner, ok := m.backward[value]; ok {
+		delete(m.forward, owner)
+	}
+	m.put(key, value)
+}
+
+func (m *BiMap[K, V]) put(key K, value V) {
+	if old, ok := m.forward[key]; ok {
+		delete(m.backward, old)
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+}
+
+func (m *BiMap[K, V]) Get(key K) optional.Optional[V] {
+	if value, ok := m.forward[key]; ok {
+		return optional.Of(value)
+	}
+	return optional.Empty[V]()
+}
+
+// Lookup returns the value for the key and whether it was present
+func (m *BiMap[K, V]) Lookup(key K) (V, bool) {
+	value, ok := m.forward[key]
+	return value, ok
+}
+
+func (m *BiMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := m.forward[key]; ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.forward[key]
+	return ok
+}
+
+// ContainsValue reports whether some key maps to the value, in constant time
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, ok := m.backward[value]
+	return ok
+}
+
+func (m *BiMap[K, V]) Remove(key K) {
+	if value, ok := m.forward[key]; ok {
+		delete(m.forward, key)
+		delete(m.backward, value)
+	}
+}
+
+func (m *BiMap[K, V]) Clear() {
+	clear(m.forward)
+	clear(m.backward)
+}
+
+// Inverse returns the view of this map from values to keys. It shares storage with this map.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return m.init().inverse
+}
+
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+func (m *BiMap[K, V]) Keys() List[K] {
+	return MutableMap[K, V](m.forward).Keys()
+}
+
+func (m *BiMap[K, V]) Values() List[V] {
+	return MutableMap[K, V](m.forward).Values()
+}
+
+func (m *BiMap[K, V]) Entries() []Pair[K, V] {
+	return MutableMap[K, V](m.forward).Entries()
+}
+
+func (m *BiMap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *BiMap[K, V]) ForEach(consumer function.BiConsumer[K, V]) {
+	for k, v := range m.forward {
+		consumer(k, v)
+	}
+}
+
+// ToMap returns a copy of the entries as a MutableMap
+func (m *BiMap[K, V]) ToMap() MutableMap[K, V] {
+	return MutableMap[K, V](m.forward).Copy()
+}
+
+func (m *BiMap[K, V]) String() string {
+	return MutableMap[K, V](m.forward).String()
+}
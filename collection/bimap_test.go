@@ -0,0 +1,110 @@
+package collection_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestBiMapPut(t *testing.T) {
+	t.Run("looks up in both directions", func(t *testing.T) {
+		var m collection.BiMap[int, string]
+		assert.NoError(t, m.Put(1, "one"))
+		assert.NoError(t, m.Put(2, "two"))
+
+		assert.Equal(t, "one", m.Get(1).GetValue())
+		assert.Equal(t, 2, m.Inverse().Get("two").GetValue())
+		assert.True(t, m.ContainsValue("two"))
+		assert.False(t, m.ContainsValue("three"))
+		assert.Equal(t, 2, m.Len())
+	})
+
+	t.Run("rejects a value owned by another key", func(t *testing.T) {
+		m := collection.NewBiMap[int, string]()
+		assert.NoError(t, m.Put(1, "one"))
+
+		err := m.Put(2, "one")
+		assert.True(t, errors.Is(err, collection.DuplicateValueError))
+		assert.Equal(t, "value already present: one is mapped from 1", err.Error())
+		assert.False(t, m.ContainsKey(2))
+		assert.NoError(t, m.Put(1, "one"))
+	})
+
+	t.Run("replaces the previous value of a key", func(t *testing.T) {
+		m := collection.NewBiMap[int, string]()
+		assert.NoError(t, m.Put(1, "one"))
+		assert.NoError(t, m.Put(1, "uno"))
+
+		assert.False(t, m.ContainsValue("one"))
+		assert.Equal(t, 1, m.Inverse().Get("uno").GetValue())
+		assert.Equal(t, 1, m.Len())
+	})
+
+	t.Run("ForcePut steals the value from its previous key", func(t *testing.T) {
+		m := collection.NewBiMap[int, string]()
+		assert.NoError(t, m.Put(1, "one"))
+		assert.NoError(t, m.Put(2, "two"))
+		m.ForcePut(2, "one")
+
+		assert.False(t, m.ContainsKey(1))
+		assert.False(t, m.ContainsValue("two"))
+		assert.Equal(t, 2, m.Inverse().Get("one").GetValue())
+		assert.Equal(t, 1, m.Len())
+	})
+
+	t.Run("BiMapOf fails on duplicate values", func(t *testing.T) {
+		_, err := collection.BiMapOf(collection.PairOf("a", 1), collection.PairOf("b", 1))
+		assert.True(t, errors.Is(err, collection.DuplicateValueError))
+
+		m, err := collection.BiMapOf(collection.PairOf("a", 1), collection.PairOf("b", 2))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, m.Len())
+	})
+}
+
+func TestBiMapInverseSharesStorage(t *testing.T) {
+	m := collection.NewBiMap[string, int]()
+	inverse := m.Inverse()
+
+	assert.NoError(t, inverse.Put(1, "a"))
+	assert.Equal(t, 1, m.Get("a").GetValue())
+
+	m.Remove("a")
+	assert.True(t, inverse.IsEmpty())
+
+	assert.NoError(t, m.Put("b", 2))
+	inverse.Remove(2)
+	assert.False(t, m.ContainsKey("b"))
+
+	assert.True(t, m == inverse.Inverse())
+}
+
+func TestBiMapViews(t *testing.T) {
+	m, _ := collection.BiMapOf(collection.PairOf("a", 1), collection.PairOf("b", 2))
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, collection.List[string]{"a", "b"}, keys)
+	assert.Equal(t, 2, len(m.Values()))
+	assert.Equal(t, 2, len(m.Entries()))
+	assert.MapEqual(t, map[string]int{"a": 1, "b": 2}, m.ToMap())
+	assert.Equal(t, `{"a":1,"b":2}`, m.String())
+	assert.Equal(t, 9, m.GetOrDefault("z", 9))
+	value, ok := m.Lookup("b")
+	assert.Equal(t, 2, value)
+	assert.True(t, ok)
+
+	sum := 0
+	m.ForEach(func(_ string, v int) { sum += v })
+	for _, v := range m.Iter() {
+		sum += v
+	}
+	assert.Equal(t, 6, sum)
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.True(t, m.Inverse().IsEmpty())
+}
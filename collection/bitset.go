@@ -0,0 +1,234 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+// BitSet is a set of non-negative ints stored as one bit each in a slice of words,
+// much smaller than a Set[int] for dense ranges. It grows as needed; the zero value is an empty BitSet.
+// Methods taking an index panic if it is negative.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet creates an empty BitSet with room for indexes below capacity before it has to grow
+func NewBitSet(capacity int) *BitSet {
+	return &BitSet{words: make([]uint64, 0, (capacity+63)/64)}
+}
+
+func BitSetOf(indexes ...int) *BitSet {
+	b := &BitSet{}
+	for _, index := range indexes {
+		b.Set(index)
+	}
+	return b
+}
+
+// BitSetFrom creates a BitSet holding the ints of the set, panicking if one is negative
+func BitSetFrom(set Set[int]) *BitSet {
+	b := &BitSet{}
+	for index := range set {
+		b.Set(index)
+	}
+	return b
+}
+
+func (b *BitSet) Set(index int) {
+	word := wordIndex(index)
+	if word >= len(b.words) {
+		b.words = append(b.words, make([]uint64, word+1-len(b.words))...)
+	}
+	b.words[word] |= 1 << (index % 64)
+}
+
+func (b *BitSet) Clear(index int) {
+	if word := wordIndex(index); word < len(b.words) {
+		b.words[word] &^= 1 << (index % 64)
+	}
+}
+
+func (b *BitSet) Test(index int) bool {
+	word := wordIndex(index)
+	return word < len(b.words) && b.words[word]&(1<<(index%64)) != 0
+}
+
+func (b *BitSet) Flip(index int) {
+	if b.Test(index) {
+		b.Clear(index)
+	} else {
+		b.Set(index)
+	}
+}
+
+// Reset clears every bit
+func (b *BitSet) Reset() {
+	b.words = b.words[:0]
+}
+
+// Cardinality returns the number of set bits
+func (b *BitSet) Cardinality() (count int) {
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return
+}
+
+func (b *BitSet) IsEmpty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// NextSetBit returns the first set index at or after from, or -1 if there is none
+func (b *BitSet) NextSetBit(from int) int {
+	word := wordIndex(from)
+	if word >= len(b.words) {
+		return -1
+	}
+	if rest := b.words[word] >> (from % 64); rest != 0 {
+		return from + bits.TrailingZeros64(rest)
+	}
+	for word++; word < len(b.words); word++ {
+		if b.words[word] != 0 {
+			return word*64 + bits.TrailingZeros64(b.words[word])
+		}
+	}
+	return -1
+}
+
+// NextClearBit returns the first clear index at or after from
+func (b *BitSet) NextClearBit(from int) int {
+	word := wordIndex(from)
+	if word >= len(b.words) {
+		return from
+	}
+	if rest := ^b.words[word] >> (from % 64); rest != 0 {
+		return from + bits.TrailingZeros64(rest)
+	}
+	for word++; word < len(b.words); word++ {
+		if b.words[word] != ^uint64(0) {
+			return word*64 + bits.TrailingZeros64(^b.words[word])
+		}
+	}
+	return len(b.words) * 64
+}
+
+func (b *BitSet) Union(other *BitSet) *BitSet {
+	result := b.Copy()
+	for len(result.words) < len(other.words) {
+		result.words = append(result.words, 0)
+	}
+	for i, word := range other.words {
+		result.words[i] |= word
+	}
+	return result
+}
+
+func (b *BitSet) Intersect(other *BitSet) *BitSet {
+	result := &BitSet{words: make([]uint64, min(len(b.words), len(other.words)))}
+	for i := range result.words {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	return result
+}
+
+func (b *BitSet) Subtract(other *BitSet) *BitSet {
+	result := b.Copy()
+	for i := range min(len(result.words), len(other.words)) {
+		result.words[i] &^= other.words[i]
+	}
+	return result
+}
+
+// SymmetricDifference returns the indexes set in exactly one of the two bitsets
+func (b *BitSet) SymmetricDifference(other *BitSet) *BitSet {
+	result := b.Copy()
+	for len(result.words) < len(other.words) {
+		result.words = append(result.words, 0)
+	}
+	for i, word := range other.words {
+		result.words[i] ^= word
+	}
+	return result
+}
+
+// Equal reports whether both bitsets hold the same indexes, regardless of their capacity
+func (b *BitSet) Equal(other *BitSet) bool {
+	longer, shorter := b.words, other.words
+	if len(longer) < len(shorter) {
+		longer, shorter = shorter, longer
+	}
+	for i, word := range longer {
+		if i < len(shorter) && shorter[i] != word || i >= len(shorter) && word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *BitSet) Copy() *BitSet {
+	return &BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// Iter returns an iterator over the set indexes in ascending order
+func (b *BitSet) Iter() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for index := b.NextSetBit(0); index >= 0; index = b.NextSetBit(index + 1) {
+			if !yield(index) {
+				return
+			}
+		}
+	}
+}
+
+func (b *BitSet) ForEach(fn func(int)) {
+	for index := range b.Iter() {
+		fn(index)
+	}
+}
+
+func (b *BitSet) ToList() List[int] {
+	result := make(List[int], 0, b.Cardinality())
+	for index := range b.Iter() {
+		result = append(result, index)
+	}
+	return result
+}
+
+func (b *BitSet) ToSet() Set[int] {
+	result := make(Set[int], b.Cardinality())
+	for index := range b.Iter() {
+		result.Add(index)
+	}
+	return result
+}
+
+func (b *BitSet) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for index := range b.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%d", index))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
+
+func wordIndex(index int) int {
+	if index < 0 {
+		panic(fmt.Sprintf("negative bit index: %d", index))
+	}
+	return index / 64
+}
@@ -0,0 +1,119 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestBitSetBits(t *testing.T) {
+	t.Run("sets, clears and flips bits across words", func(t *testing.T) {
+		var b collection.BitSet
+		b.Set(0)
+		b.Set(63)
+		b.Set(64)
+		b.Set(1000)
+		b.Clear(63)
+		b.Clear(5000)
+		b.Flip(1)
+		b.Flip(1000)
+
+		assert.True(t, b.Test(0))
+		assert.True(t, b.Test(1))
+		assert.False(t, b.Test(63))
+		assert.True(t, b.Test(64))
+		assert.False(t, b.Test(1000))
+		assert.False(t, b.Test(99999))
+		assert.Equal(t, 3, b.Cardinality())
+		assert.Equal(t, "{0, 1, 64}", b.String())
+	})
+
+	t.Run("resets and reports emptiness", func(t *testing.T) {
+		b := collection.NewBitSet(256)
+		assert.True(t, b.IsEmpty())
+		b.Set(200)
+		assert.False(t, b.IsEmpty())
+		b.Clear(200)
+		assert.True(t, b.IsEmpty())
+		b.Set(3)
+		b.Reset()
+		assert.Equal(t, 0, b.Cardinality())
+	})
+
+	t.Run("panics on negative indexes", func(t *testing.T) {
+		b := collection.BitSetOf(1)
+		assert.Panics(t, func() { b.Set(-1) })
+		assert.Panics(t, func() { b.Test(-1) })
+		assert.Panics(t, func() { b.NextSetBit(-1) })
+	})
+}
+
+func TestBitSetNextBits(t *testing.T) {
+	b := collection.BitSetOf(3, 64, 130)
+
+	assert.Equal(t, 3, b.NextSetBit(0))
+	assert.Equal(t, 3, b.NextSetBit(3))
+	assert.Equal(t, 64, b.NextSetBit(4))
+	assert.Equal(t, 130, b.NextSetBit(65))
+	assert.Equal(t, -1, b.NextSetBit(131))
+	assert.Equal(t, -1, b.NextSetBit(10000))
+
+	full := collection.NewBitSet(128)
+	for i := 0; i < 128; i++ {
+		full.Set(i)
+	}
+	full.Clear(70)
+	assert.Equal(t, 0, b.NextClearBit(0))
+	assert.Equal(t, 4, b.NextClearBit(3))
+	assert.Equal(t, 70, full.NextClearBit(0))
+	full.Set(70)
+	assert.Equal(t, 128, full.NextClearBit(0))
+	assert.Equal(t, 500, full.NextClearBit(500))
+}
+
+func TestBitSetOperations(t *testing.T) {
+	left := collection.BitSetOf(1, 2, 100)
+	right := collection.BitSetOf(2, 3)
+
+	assert.Equal(t, collection.List[int]{1, 2, 3, 100}, left.Union(right).ToList())
+	assert.Equal(t, collection.List[int]{1, 2, 3, 100}, right.Union(left).ToList())
+	assert.Equal(t, collection.List[int]{2}, left.Intersect(right).ToList())
+	assert.Equal(t, collection.List[int]{1, 100}, left.Subtract(right).ToList())
+	assert.Equal(t, collection.List[int]{3}, right.Subtract(left).ToList())
+	assert.Equal(t, collection.List[int]{1, 3, 100}, left.SymmetricDifference(right).ToList())
+	assert.Equal(t, collection.List[int]{1, 3, 100}, right.SymmetricDifference(left).ToList())
+	assert.Equal(t, collection.List[int]{1, 2, 100}, left.ToList())
+
+	t.Run("Equal ignores capacity", func(t *testing.T) {
+		grown := collection.BitSetOf(1, 500)
+		grown.Clear(500)
+		assert.True(t, grown.Equal(collection.BitSetOf(1)))
+		assert.True(t, collection.BitSetOf(1).Equal(grown))
+		assert.False(t, grown.Equal(collection.BitSetOf(2)))
+		assert.False(t, collection.BitSetOf(1, 300).Equal(grown))
+	})
+
+	t.Run("Copy is independent", func(t *testing.T) {
+		copied := left.Copy()
+		copied.Set(7)
+		assert.False(t, left.Test(7))
+	})
+}
+
+func TestBitSetConversions(t *testing.T) {
+	b := collection.BitSetFrom(collection.Set[int]{5: {}, 70: {}})
+
+	assert.MapEqual(t, collection.Set[int]{5: {}, 70: {}}, b.ToSet())
+	assert.Equal(t, collection.List[int]{5, 70}, b.ToList())
+	assert.Panics(t, func() { collection.BitSetFrom(collection.Set[int]{-1: {}}) })
+
+	var visited []int
+	b.ForEach(func(i int) { visited = append(visited, i) })
+	assert.Equal(t, []int{5, 70}, visited)
+
+	for i := range b.Iter() {
+		assert.Equal(t, 5, i)
+		break
+	}
+}
@@ -0,0 +1,374 @@
+package collection
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"iter"
+	"runtime"
+	"sync"
+
+	"github.com/marlonbarreto-git/gollections/internal/hashing"
+	"github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+	"github.com/marlonbarreto-git/gollections/tomove/types"
+)
+
+// ConcurrentMap is a map that is safe for concurrent use. Keys are spread over independently
+// locked shards, so goroutines working on different keys rarely contend.
+// Single-key operations such as GetOrPut and the Compute family are atomic. Whole-map operations
+// (Len, Keys, iteration, ...) visit one shard at a time and are not a consistent snapshot under concurrent writes.
+type ConcurrentMap[K comparable, V any] struct {
+	shards []concurrentShard[K, V]
+	seed   maphash.Seed
+}
+
+type concurrentShard[K comparable, V any] struct {
+	sync.RWMutex
+	items map[K]V
+}
+
+// NewConcurrentMap creates an empty ConcurrentMap with a shard count suited to the number of CPUs
+func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
+	return NewConcurrentMapWithShards[K, V](4 * runtime.GOMAXPROCS(0))
+}
+
+// NewConcurrentMapWithShards creates an empty ConcurrentMap split into at least the given number of shards,
+// rounded up to a power of two
+func NewConcurrentMapWithShards[K comparable, V any](shards int) *ConcurrentMap[K, V] {
+	count := 1
+	for count < shards {
+		count <<= 1
+	}
+
+	m := &ConcurrentMap[K, V]{shards: make([]concurrentShard[K, V], count), seed: maphash.MakeSeed()}
+	for i := range m.shards {
+		m.shards[i].items = map[K]V{}
+	}
+	return m
+}
+
+func ConcurrentMapOf[K comparable, V any](pairs ...Pair[K, V]) *ConcurrentMap[K, V] {
+	m := NewConcurrentMap[K, V]()
+	m.PutAll(pairs...)
+	return m
+}
+
+func (m *ConcurrentMap[K, V]) shard(key K) *concurrentShard[K, V] {
+	return &m.shards[hashing.Comparable(m.seed, key)&uint64(len(m.shards)-1)]
+}
+
+func (m *ConcurrentMap[K, V]) Put(key K, value V) {
+	s := m.shard(key)
+	s.Lock()
+	s.items[key] = value
+	s.Unlock()
+}
+
+func (m *ConcurrentMap[K, V]) PutAll(pairs ...Pair[K, V]) {
+	for _, pair := range pairs {
+		m.Put(pair.First(), pair.Second())
+	}
+}
+
+func (m *ConcurrentMap[K, V]) Get(key K) optional.Optional[V] {
+	if value, ok := m.Lookup(key); ok {
+		return optional.Of(value)
+	}
+	return optional.Empty[V]()
+}
+
+// Lookup returns the value for the key and whether it was present
+func (m *ConcurrentMap[K, V]) Lookup(key K) (V, bool) {
+	s := m.shard(key)
+	s.RLock()
+	defer s.RUnlock()
+	value, ok := s.items[key]
+	return value, ok
+}
+
+func (m *ConcurrentMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := m.Lookup(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// GetOrPut returns the value for the key, storing the result of defaultFn first if it is absent.
+// defaultFn runs at most once per missing key, while the key's shard is locked, so it must not use the map.
+func (m *ConcurrentMap[K, V]) GetOrPut(key K, defaultFn func() V) V {
+	return m.ComputeIfAbsent(key, func(K) V {
+		return defaultFn()
+	})
+}
+
+// ComputeIfAbsent atomically stores and returns fn(key) if the key is absent, or returns the current value.
+// fn runs while the key's shard is locked, so it must not use the map.
+func (m *ConcurrentMap[K, V]) ComputeIfAbsent(key K, fn func(K) V) V {
+	if value, ok := m.Lookup(key); ok {
+		return value
+	}
+
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	if value, ok := s.items[key]; ok {
+		return value
+	}
+	value := fn(key)
+	s.items[key] = value
+	return value
+}
+
+// ComputeIfPresent atomically replaces the value of a present key with the one fn returns,
+// or removes the key if fn returns false. It returns the resulting value and whether the key is present.
+// fn runs while the key's shard is locked, so it must not use the map.
+func (m *ConcurrentMap[K, V]) ComputeIfPresent(key K, fn func(K, V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(key K, value V, present bool) (V, bool) {
+		if !present {
+			return value, false
+		}
+		return fn(key, value)
+	})
+}
+
+// Compute atomically replaces the entry for the key with the value fn returns, or removes it if fn returns false.
+// fn receives the current value and whether the key was present. Compute returns the resulting value and whether the key is present.
+// fn runs while the key's shard is locked, so it must not use the map.
+func (m *ConcurrentMap[K, V]) Compute(key K, fn func(key K, value V, present bool) (V, bool)) (V, bool) {
+	s := m.shard(key)
+	s.Lock()
+	defer s.Unlock()
+
+	value, present := s.items[key]
+	value, keep := fn(key, value, present)
+	if keep {
+		s.items[key] = value
+	} else {
+		delete(s.items, key)
+	}
+	return value, keep
+}
+
+// MergeValue atomically stores value for an absent key, or resolver(current, value) for a present one,
+// and returns the stored value. resolver runs while the key's shard is locked, so it must not use the map.
+func (m *ConcurrentMap[K, V]) MergeValue(key K, value V, resolver func(current, value V) V) V {
+	merged, _ := m.Compute(key, func(_ K, current V, present bool) (V, bool) {
+		if present {
+			return resolver(current, value), true
+		}
+		return value, true
+	})
+	return merged
+}
+
+func (m *ConcurrentMap[K, V]) Merge(other MutableMap[K, V]) {
+	for k, v := range other {
+		m.Put(k, v)
+	}
+}
+
+func (m *ConcurrentMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Lookup(key)
+	return ok
+}
+
+func (m *ConcurrentMap[K, V]) ContainsValue(value V) bool {
+	var valAny any = value
+	return m.Any(func(_ K, v V) bool {
+		var vAny any = v
+		return valAny == vAny
+	})
+}
+
+func (m *ConcurrentMap[K, V]) Remove(key K) {
+	s := m.shard(key)
+	s.Lock()
+	delete(s.items, key)
+	s.Unlock()
+}
+
+func (m *ConcurrentMap[K, V]) Clear() {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.Lock()
+		clear(s.items)
+		s.Unlock()
+	}
+}
+
+func (m *ConcurrentMap[K, V]) Len() (length int) {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.RLock()
+		length += len(s.items)
+		s.RUnlock()
+	}
+	return
+}
+
+func (m *ConcurrentMap[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Iter returns an iterator over the entries. Each shard is copied before its entries are yielded,
+// so the loop body may freely read and write the map.
+func (m *ConcurrentMap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range m.shards {
+			for k, v := range m.shards[i].copy() {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *concurrentShard[K, V]) copy() map[K]V {
+	s.RLock()
+	defer s.RUnlock()
+	copied := make(map[K]V, len(s.items))
+	for k, v := range s.items {
+		copied[k] = v
+	}
+	return copied
+}
+
+func (m *ConcurrentMap[K, V]) Map(fn func(K, V) (any, any)) MutableMap[any, any] {
+	return m.Copy().Map(fn)
+}
+
+func (m *ConcurrentMap[K, V]) Reduce(fn func(acc any, key K, value V) any, acc any) any {
+	for k, v := range m.Iter() {
+		acc = fn(acc, k, v)
+	}
+	return acc
+}
+
+func (m *ConcurrentMap[K, V]) ForEach(consumer function.BiConsumer[K, V]) {
+	for k, v := range m.Iter() {
+		consumer(k, v)
+	}
+}
+
+func (m *ConcurrentMap[K, V]) Filter(predicate function.BiPredicate[K, V]) MutableMap[K, V] {
+	filteredMap := map[K]V{}
+	for k, v := range m.Iter() {
+		if predicate(k, v) {
+			filteredMap[k] = v
+		}
+	}
+	return filteredMap
+}
+
+func (m *ConcurrentMap[K, V]) FilterKeys(predicate func(K) bool) MutableMap[K, V] {
+	return m.Filter(func(k K, _ V) bool {
+		return predicate(k)
+	})
+}
+
+func (m *ConcurrentMap[K, V]) FilterValues(predicate func(V) bool) MutableMap[K, V] {
+	return m.Filter(func(_ K, v V) bool {
+		return predicate(v)
+	})
+}
+
+func (m *ConcurrentMap[K, V]) Count(predicate function.BiPredicate[K, V]) (count int) {
+	for k, v := range m.Iter() {
+		if predicate(k, v) {
+			count++
+		}
+	}
+	return
+}
+
+func (m *ConcurrentMap[K, V]) Any(predicate function.BiPredicate[K, V]) bool {
+	for k, v := range m.Iter() {
+		if predicate(k, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *ConcurrentMap[K, V]) All(predicate function.BiPredicate[K, V]) bool {
+	for k, v := range m.Iter() {
+		if !predicate(k, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *ConcurrentMap[K, V]) None(predicate function.BiPredicate[K, V]) bool {
+	return !m.Any(predicate)
+}
+
+// Copy returns a plain MutableMap holding the current entries
+func (m *ConcurrentMap[K, V]) Copy() MutableMap[K, V] {
+	return m.Filter(func(K, V) bool { return true })
+}
+
+func (m *ConcurrentMap[K, V]) Keys() (keys List[K]) {
+	for k := range m.Iter() {
+		keys = append(keys, k)
+	}
+	return
+}
+
+func (m *ConcurrentMap[K, V]) Values() (values List[V]) {
+	for _, v := range m.Iter() {
+		values = append(values, v)
+	}
+	return
+}
+
+func (m *ConcurrentMap[K, V]) Entries() (values []Pair[K, V]) {
+	for k, v := range m.Iter() {
+		values = append(values, PairOf(k, v))
+	}
+	return
+}
+
+func (m *ConcurrentMap[K, V]) ToList() []Pair[K, V] {
+	result := make([]Pair[K, V], 0, m.Len())
+	for k, v := range m.Iter() {
+		result = append(result, PairOf(k, v))
+	}
+	return result
+}
+
+func (m *ConcurrentMap[K, V]) ToSet() Set[K] {
+	result := Set[K]{}
+	for k := range m.Iter() {
+		result[k] = types.EmptyInstance
+	}
+	return result
+}
+
+func (m *ConcurrentMap[K, V]) String() string {
+	return m.Copy().String()
+}
+
+func (m *ConcurrentMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[K]V(m.Copy()))
+}
+
+func (m *ConcurrentMap[K, V]) Also(fn func(*ConcurrentMap[K, V])) *ConcurrentMap[K, V] {
+	fn(m)
+	return m
+}
+
+func (m *ConcurrentMap[K, V]) TakeIf(predicate func(*ConcurrentMap[K, V]) bool) optional.Optional[*ConcurrentMap[K, V]] {
+	if predicate(m) {
+		return optional.Of(m)
+	}
+	return optional.Empty[*ConcurrentMap[K, V]]()
+}
+
+func (m *ConcurrentMap[K, V]) TakeUnless(predicate func(*ConcurrentMap[K, V]) bool) optional.Optional[*ConcurrentMap[K, V]] {
+	if !predicate(m) {
+		return optional.Of(m)
+	}
+	return optional.Empty[*ConcurrentMap[K, V]]()
+}
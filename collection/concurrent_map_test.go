@@ -0,0 +1,203 @@
+package collection_test
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestConcurrentMapBasics(t *testing.T) {
+	t.Run("puts, gets and removes entries", func(t *testing.T) {
+		m := collection.NewConcurrentMap[string, int]()
+		m.Put("a", 1)
+		m.PutAll(collection.PairOf("b", 2), collection.PairOf("c", 0))
+
+		assert.Equal(t, 3, m.Len())
+		assert.Equal(t, 1, m.Get("a").GetValue())
+		assert.True(t, m.Get("z").IsEmpty())
+		value, ok := m.Lookup("c")
+		assert.Equal(t, 0, value)
+		assert.True(t, ok)
+		assert.Equal(t, 9, m.GetOrDefault("z", 9))
+		assert.True(t, m.ContainsKey("b"))
+		assert.True(t, m.ContainsValue(2))
+		assert.False(t, m.ContainsValue(7))
+
+		m.Remove("a")
+		assert.False(t, m.ContainsKey("a"))
+		m.Clear()
+		assert.True(t, m.IsEmpty())
+	})
+
+	t.Run("rounds the shard count up to a power of two", func(t *testing.T) {
+		m := collection.NewConcurrentMapWithShards[int, int](3)
+		for i := 0; i < 100; i++ {
+			m.Put(i, i)
+		}
+		assert.Equal(t, 100, m.Len())
+		assert.Equal(t, 42, m.Get(42).GetValue())
+	})
+
+	t.Run("treats equal keys alike", func(t *testing.T) {
+		floats := collection.NewConcurrentMapWithShards[float64, string](64)
+		floats.Put(0, "zero")
+		assert.Equal(t, "zero", floats.Get(math.Copysign(0, -1)).GetValue())
+
+		type key struct {
+			name string
+			id   any
+		}
+		structs := collection.NewConcurrentMapWithShards[key, int](64)
+		structs.Put(key{"a", 1}, 1)
+		assert.Equal(t, 1, structs.Get(key{"a", 1}).GetValue())
+		assert.False(t, structs.ContainsKey(key{"a", int64(1)}))
+	})
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	t.Run("ComputeIfAbsent only stores missing keys", func(t *testing.T) {
+		m := collection.ConcurrentMapOf(collection.PairOf("a", 1))
+		length := func(k string) int { return len(k) * 10 }
+
+		assert.Equal(t, 1, m.ComputeIfAbsent("a", length))
+		assert.Equal(t, 20, m.ComputeIfAbsent("bb", length))
+		assert.Equal(t, 20, m.Get("bb").GetValue())
+	})
+
+	t.Run("ComputeIfPresent updates or removes present keys", func(t *testing.T) {
+		m := collection.ConcurrentMapOf(collection.PairOf("a", 1), collection.PairOf("b", 2))
+		double := func(_ string, v int) (int, bool) { return v * 2, v < 2 }
+
+		value, ok := m.ComputeIfPresent("a", double)
+		assert.Equal(t, 2, value)
+		assert.True(t, ok)
+		_, ok = m.ComputeIfPresent("b", double)
+		assert.False(t, ok)
+		assert.False(t, m.ContainsKey("b"))
+		_, ok = m.ComputeIfPresent("z", double)
+		assert.False(t, ok)
+		assert.False(t, m.ContainsKey("z"))
+	})
+
+	t.Run("Compute sees whether the key was present", func(t *testing.T) {
+		m := collection.NewConcurrentMap[string, int]()
+		increment := func(_ string, v int, present bool) (int, bool) {
+			if !present {
+				return 1, true
+			}
+			return v + 1, true
+		}
+
+		m.Compute("a", increment)
+		value, _ := m.Compute("a", increment)
+		assert.Equal(t, 2, value)
+
+		m.Compute("a", func(string, int, bool) (int, bool) { return 0, false })
+		assert.False(t, m.ContainsKey("a"))
+	})
+
+	t.Run("MergeValue resolves conflicts", func(t *testing.T) {
+		m := collection.NewConcurrentMap[string, int]()
+		sum := func(current, value int) int { return current + value }
+
+		assert.Equal(t, 5, m.MergeValue("a", 5, sum))
+		assert.Equal(t, 8, m.MergeValue("a", 3, sum))
+	})
+
+	t.Run("Merge overwrites from a MutableMap", func(t *testing.T) {
+		m := collection.ConcurrentMapOf(collection.PairOf("a", 1), collection.PairOf("b", 2))
+		m.Merge(collection.MutableMap[string, int]{"b": 20, "c": 30})
+
+		assert.MapEqual(t, map[string]int{"a": 1, "b": 20, "c": 30}, m.Copy())
+	})
+}
+
+func TestConcurrentMapViews(t *testing.T) {
+	m := collection.ConcurrentMapOf(collection.PairOf("a", 1), collection.PairOf("b", 2), collection.PairOf("c", 3))
+	odd := func(_ string, v int) bool { return v%2 == 1 }
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, collection.List[string]{"a", "b", "c"}, keys)
+	assert.Equal(t, 6.0, collection.SumOf(m.Values(), func(v int) float64 { return float64(v) }))
+	assert.Equal(t, 3, len(m.Entries()))
+	assert.Equal(t, 3, len(m.ToList()))
+	assert.Equal(t, 3, m.ToSet().Len())
+	assert.MapEqual(t, map[string]int{"a": 1, "c": 3}, m.Filter(odd))
+	assert.MapEqual(t, map[string]int{"b": 2}, m.FilterKeys(func(k string) bool { return k == "b" }))
+	assert.MapEqual(t, map[string]int{"c": 3}, m.FilterValues(func(v int) bool { return v > 2 }))
+	assert.Equal(t, 2, m.Count(odd))
+	assert.True(t, m.Any(odd))
+	assert.False(t, m.All(odd))
+	assert.False(t, m.None(odd))
+	assert.Equal(t, 6, m.Reduce(func(acc any, _ string, v int) any { return acc.(int) + v }, 0).(int))
+	assert.Equal(t, 3, m.Map(func(k string, v int) (any, any) { return v, k }).Len())
+	assert.Equal(t, `{"a":1,"b":2,"c":3}`, m.String())
+
+	visited := 0
+	m.ForEach(func(string, int) { visited++ })
+	assert.Equal(t, 3, visited)
+
+	for k := range m.Iter() {
+		m.Remove(k)
+	}
+	assert.True(t, m.IsEmpty())
+
+	assert.True(t, m.TakeIf(func(m *collection.ConcurrentMap[string, int]) bool { return m.IsEmpty() }).IsPresent())
+	assert.True(t, m.TakeUnless(func(m *collection.ConcurrentMap[string, int]) bool { return m.IsEmpty() }).IsEmpty())
+	assert.Equal(t, 1, m.Also(func(m *collection.ConcurrentMap[string, int]) { m.Put("x", 1) }).Len())
+}
+
+func TestConcurrentMapConcurrentUse(t *testing.T) {
+	t.Run("GetOrPut builds each value once", func(t *testing.T) {
+		m := collection.NewConcurrentMap[int, string]()
+		var builds atomic.Int32
+		var wg sync.WaitGroup
+
+		for worker := 0; worker < 8; worker++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					m.GetOrPut(i%100, func() string {
+						builds.Add(1)
+						return strconv.Itoa(i % 100)
+					})
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(100), builds.Load())
+		assert.Equal(t, 100, m.Len())
+	})
+
+	t.Run("MergeValue counts without lost updates", func(t *testing.T) {
+		m := collection.NewConcurrentMap[string, int]()
+		sum := func(current, value int) int { return current + value }
+		var wg sync.WaitGroup
+
+		for worker := 0; worker < 8; worker++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					m.MergeValue(strconv.Itoa(i%10), 1, sum)
+					m.Len()
+					m.ForEach(func(string, int) {})
+				}
+			}()
+		}
+		wg.Wait()
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, 800, m.Get(strconv.Itoa(i)).GetValue())
+		}
+	})
+}
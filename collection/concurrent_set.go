@@ -0,0 +1,183 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+	. "github.com/marlonbarreto-git/gollections/tomove/types"
+)
+
+// ConcurrentSet is a set that is safe for concurrent use, backed by a ConcurrentMap.
+// Add is atomic, so exactly one of several goroutines adding the same item sees true.
+type ConcurrentSet[K comparable] struct {
+	items *ConcurrentMap[K, Empty]
+}
+
+func NewConcurrentSet[K comparable]() *ConcurrentSet[K] {
+	return &ConcurrentSet[K]{items: NewConcurrentMap[K, Empty]()}
+}
+
+func ConcurrentSetOf[K comparable](items ...K) *ConcurrentSet[K] {
+	s := NewConcurrentSet[K]()
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+func (s *ConcurrentSet[K]) Contains(item K) bool {
+	return s.items.ContainsKey(item)
+}
+
+// Add adds the item and reports whether it was not already present
+func (s *ConcurrentSet[K]) Add(item K) bool {
+	added := false
+	s.items.ComputeIfAbsent(item, func(K) Empty {
+		added = true
+		return EmptyInstance
+	})
+	return added
+}
+
+func (s *ConcurrentSet[K]) Remove(item K) {
+	s.items.Remove(item)
+}
+
+func (s *ConcurrentSet[K]) Clear() {
+	s.items.Clear()
+}
+
+func (s *ConcurrentSet[K]) IsEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+func (s *ConcurrentSet[K]) Len() int {
+	return s.items.Len()
+}
+
+func (s *ConcurrentSet[K]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for k := range s.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v", k))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
+
+// Iter returns an iterator over the items; the loop body may freely modify the set
+func (s *ConcurrentSet[K]) Iter() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s.items.Iter() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (s *ConcurrentSet[K]) Values() List[K] {
+	return s.items.Keys()
+}
+
+func (s *ConcurrentSet[K]) Union(other Set[K]) Set[K] {
+	return s.ToSet().Union(other)
+}
+
+func (s *ConcurrentSet[K]) Intersect(other Set[K]) Set[K] {
+	return s.ToSet().Intersect(other)
+}
+
+func (s *ConcurrentSet[K]) Subtract(other Set[K]) Set[K] {
+	return s.ToSet().Subtract(other)
+}
+
+func (s *ConcurrentSet[K]) Filter(predicate func(K) bool) Set[K] {
+	result := Set[K]{}
+	for k := range s.Iter() {
+		if predicate(k) {
+			result[k] = EmptyInstance
+		}
+	}
+	return result
+}
+
+func (s *ConcurrentSet[K]) ForEach(fn func(K)) {
+	for k := range s.Iter() {
+		fn(k)
+	}
+}
+
+func (s *ConcurrentSet[K]) Any(predicate func(K) bool) bool {
+	for k := range s.Iter() {
+		if predicate(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ConcurrentSet[K]) All(predicate func(K) bool) bool {
+	for k := range s.Iter() {
+		if !predicate(k) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *ConcurrentSet[K]) None(predicate func(K) bool) bool {
+	return !s.Any(predicate)
+}
+
+func (s *ConcurrentSet[K]) First() optional.Optional[K] {
+	for k := range s.Iter() {
+		return optional.Of(k)
+	}
+	return optional.Empty[K]()
+}
+
+func (s *ConcurrentSet[K]) ToList() []K {
+	return s.items.Keys()
+}
+
+// ToSet returns a plain Set holding the current items
+func (s *ConcurrentSet[K]) ToSet() Set[K] {
+	return s.items.ToSet()
+}
+
+func (s *ConcurrentSet[K]) ToMap(valueSelector func(K) any) MutableMap[K, any] {
+	result := MutableMap[K, any]{}
+	for k := range s.Iter() {
+		result[k] = valueSelector(k)
+	}
+	return result
+}
+
+func (s *ConcurrentSet[K]) Also(fn func(*ConcurrentSet[K])) *ConcurrentSet[K] {
+	fn(s)
+	return s
+}
+
+func (s *ConcurrentSet[K]) TakeIf(predicate func(*ConcurrentSet[K]) bool) optional.Optional[*ConcurrentSet[K]] {
+	if predicate(s) {
+		return optional.Of(s)
+	}
+	return optional.Empty[*ConcurrentSet[K]]()
+}
+
+func (s *ConcurrentSet[K]) TakeUnless(predicate func(*ConcurrentSet[K]) bool) optional.Optional[*ConcurrentSet[K]] {
+	if !predicate(s) {
+		return optional.Of(s)
+	}
+	return optional.Empty[*ConcurrentSet[K]]()
+}
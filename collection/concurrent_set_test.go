@@ -0,0 +1,79 @@
+package collection_test
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestConcurrentSetBasics(t *testing.T) {
+	s := collection.ConcurrentSetOf(1, 2, 3)
+
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Contains(2))
+	assert.False(t, s.Add(2))
+	assert.True(t, s.Add(4))
+	s.Remove(1)
+	assert.False(t, s.Contains(1))
+
+	values := s.Values()
+	sort.Ints(values)
+	assert.Equal(t, collection.List[int]{2, 3, 4}, values)
+	assert.Equal(t, 3, len(s.ToList()))
+	assert.True(t, s.First().IsPresent())
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, "{}", s.String())
+}
+
+func TestConcurrentSetOperations(t *testing.T) {
+	s := collection.ConcurrentSetOf(1, 2, 3)
+	other := collection.Set[int]{2: {}, 3: {}, 4: {}}
+	even := func(n int) bool { return n%2 == 0 }
+
+	assert.MapEqual(t, collection.Set[int]{1: {}, 2: {}, 3: {}, 4: {}}, s.Union(other))
+	assert.MapEqual(t, collection.Set[int]{2: {}, 3: {}}, s.Intersect(other))
+	assert.MapEqual(t, collection.Set[int]{1: {}}, s.Subtract(other))
+	assert.MapEqual(t, collection.Set[int]{2: {}}, s.Filter(even))
+	assert.MapEqual(t, collection.Set[int]{1: {}, 2: {}, 3: {}}, s.ToSet())
+	assert.MapEqual(t, map[int]any{1: 2, 2: 4, 3: 6}, s.ToMap(func(n int) any { return n * 2 }))
+	assert.True(t, s.Any(even))
+	assert.False(t, s.All(even))
+	assert.False(t, s.None(even))
+
+	sum := 0
+	s.ForEach(func(n int) { sum += n })
+	assert.Equal(t, 6, sum)
+
+	assert.True(t, s.TakeIf(func(s *collection.ConcurrentSet[int]) bool { return s.Len() == 3 }).IsPresent())
+	assert.True(t, s.TakeUnless(func(s *collection.ConcurrentSet[int]) bool { return s.Len() == 3 }).IsEmpty())
+	assert.Equal(t, 4, s.Also(func(s *collection.ConcurrentSet[int]) { s.Add(4) }).Len())
+}
+
+func TestConcurrentSetConcurrentAdd(t *testing.T) {
+	s := collection.NewConcurrentSet[int]()
+	var added atomic.Int32
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				if s.Add(i) {
+					added.Add(1)
+				}
+				s.Contains(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(500), added.Load())
+	assert.Equal(t, 500, s.Len())
+}
@@ -0,0 +1,455 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+const skipListMaxLevel = 32
+
+// ConcurrentSortedMap is a sorted map that is safe for concurrent use, built as a lazy skip list.
+// Lookups and iteration take no locks, and writers only lock the few nodes around the key they change,
+// so writes to different parts of the map proceed in parallel.
+// Put, PutIfAbsent and Remove are atomic. Iteration never fails or repeats a key under concurrent writes:
+// it returns keys in ascending order and reflects writes made while it runs ahead of its position,
+// but is not a consistent snapshot. Len and the whole-map operations built on iteration are likewise approximate.
+type ConcurrentSortedMap[K comparable, V any] struct {
+	head    *skipNode[K, V]
+	compare Comparator[K]
+	size    atomic.Int64
+}
+
+type skipNode[K comparable, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[skipNode[K, V]]
+	sync.Mutex
+	// marked is set when the node is being removed, fullyLinked once it is linked at every level
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+// NewConcurrentSortedMap creates an empty ConcurrentSortedMap ordered by the natural order of its keys
+func NewConcurrentSortedMap[K cmp.Ordered, V any]() *ConcurrentSortedMap[K, V] {
+	return NewConcurrentSortedMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewConcurrentSortedMapFunc creates an empty ConcurrentSortedMap ordered by the given comparator
+func NewConcurrentSortedMapFunc[K comparable, V any](comparator Comparator[K]) *ConcurrentSortedMap[K, V] {
+	head := &skipNode[K, V]{next: make([]atomic.Pointer[skipNode[K, V]], skipListMaxLevel)}
+	head.fullyLinked.Store(true)
+	return &ConcurrentSortedMap[K, V]{head: head, compare: comparator}
+}
+
+func ConcurrentSortedMapOf[K cmp.Ordered, V any](pairs ...Pair[K, V]) *ConcurrentSortedMap[K, V] {
+	m := NewConcurrentSortedMap[K, V]()
+	m.PutAll(pairs...)
+	return m
+}
+
+func (m *ConcurrentSortedMap[K, V]) Put(key K, value V) {
+	m.put(key, value, true)
+}
+
+func (m *ConcurrentSortedMap[K, V]) PutAll(pairs ...Pair[K, V]) {
+	for _, pair := range pairs {
+		m.Put(pair.First(), pair.Second())
+	}
+}
+
+// PutIfAbsent stores the value only if the key is absent. It returns the value held afterwards
+// and whether it was already present.
+func (m *ConcurrentSortedMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	return m.put(key, value, false)
+}
+
+func (m *ConcurrentSortedMap[K, V]) Get(key K) optional.Optional[V] {
+	if value, ok := m.Lookup(key); ok {
+		return optional.Of(value)
+	}
+	return optional.Empty[V]()
+}
+
+// Lookup returns the value for the key and whether it was present
+func (m *ConcurrentSortedMap[K, V]) Lookup(key K) (V, bool) {
+	node := m.ceiling(key)
+	if node != nil && m.compare(node.key, key) == 0 {
+		return *node.value.Load(), true
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *ConcurrentSortedMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := m.Lookup(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (m *ConcurrentSortedMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Lookup(key)
+	return ok
+}
+
+// Remove deletes the key, returning false if it was not present
+func (m *ConcurrentSortedMap[K, V]) Remove(key K) bool {
+	var preds, succs [skipListMaxLevel]*skipNode[K, V]
+	var victim *skipNode[K, V]
+	for {
+		found := m.find(key, &preds, &succs)
+		if victim == nil {
+			if found < 0 {
+				return false
+			}
+			candidate := succs[found]
+			if !candidate.fullyLinked.Load() || len(candidate.next)-1 != found || candidate.marked.Load() {
+				// an insert that has not finished or a removal that has started: either way the key counts as absent
+				return false
+			}
+			candidate.Lock()
+			if candidate.marked.Load() {
+				candidate.Unlock()
+				return false
+			}
+			candidate.marked.Store(true)
+			victim = candidate
+		}
+
+		levels := len(victim.next)
+		unlock, valid := lockPredecessors(&preds, levels, func(level int, pred *skipNode[K, V]) bool {
+			return !pred.marked.Load() && pred.next[level].Load() == victim
+		})
+		if !valid {
+			unlock()
+			continue
+		}
+		for level := levels - 1; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+		victim.Unlock()
+		unlock()
+		m.size.Add(-1)
+		return true
+	}
+}
+
+// Clear removes every key present when it starts, one at a time
+func (m *ConcurrentSortedMap[K, V]) Clear() {
+	for key := range m.Iter() {
+		m.Remove(key)
+	}
+}
+
+func (m *ConcurrentSortedMap[K, V]) Len() int {
+	return int(m.size.Load())
+}
+
+func (m *ConcurrentSortedMap[K, V]) IsEmpty() bool {
+	return m.first() == nil
+}
+
+func (m *ConcurrentSortedMap[K, V]) FirstEntry() optional.Optional[Pair[K, V]] {
+	return skipEntryOf(m.first())
+}
+
+func (m *ConcurrentSortedMap[K, V]) LastEntry() optional.Optional[Pair[K, V]] {
+	for {
+		pred := m.head
+		for level := skipListMaxLevel - 1; level >= 0; level-- {
+			for next := pred.next[level].Load(); next != nil; next = pred.next[level].Load() {
+				pred = next
+			}
+		}
+		if pred == m.head {
+			return optional.Empty[Pair[K, V]]()
+		}
+		if entry := skipEntryOf(pred); !entry.IsEmpty() {
+			return entry
+		}
+		runtime.Gosched()
+	}
+}
+
+// FloorEntry returns the entry with the greatest key less than or equal to the given key
+func (m *ConcurrentSortedMap[K, V]) FloorEntry(key K) optional.Optional[Pair[K, V]] {
+	return m.floorEntry(key, true)
+}
+
+// CeilingEntry returns the entry with the least key greater than or equal to the given key
+func (m *ConcurrentSortedMap[K, V]) CeilingEntry(key K) optional.Optional[Pair[K, V]] {
+	return skipEntryOf(m.ceiling(key))
+}
+
+// LowerEntry returns the entry with the greatest key strictly less than the given key
+func (m *ConcurrentSortedMap[K, V]) LowerEntry(key K) optional.Optional[Pair[K, V]] {
+	return m.floorEntry(key, false)
+}
+
+// HigherEntry returns the entry with the least key strictly greater than the given key
+func (m *ConcurrentSortedMap[K, V]) HigherEntry(key K) optional.Optional[Pair[K, V]] {
+	node := m.ceiling(key)
+	if node != nil && m.compare(node.key, key) == 0 {
+		node = m.nextLive(node)
+	}
+	return skipEntryOf(node)
+}
+
+// Iter returns an iterator over the entries in ascending key order
+func (m *ConcurrentSortedMap[K, V]) Iter() iter.Seq2[K, V] {
+	return m.scan(m.first, nil)
+}
+
+// IterFrom returns an iterator over the entries whose keys are greater than or equal to fromKey, in ascending order
+func (m *ConcurrentSortedMap[K, V]) IterFrom(fromKey K) iter.Seq2[K, V] {
+	return m.scan(func() *skipNode[K, V] { return m.ceiling(fromKey) }, nil)
+}
+
+// IterRange returns an iterator over the entries whose keys range from fromKey, inclusive, to toKey, exclusive,
+// in ascending order. It panics if fromKey is greater than toKey.
+func (m *ConcurrentSortedMap[K, V]) IterRange(fromKey, toKey K) iter.Seq2[K, V] {
+	if m.compare(fromKey, toKey) > 0 {
+		panic("fromKey is greater than toKey")
+	}
+	return m.scan(func() *skipNode[K, V] { return m.ceiling(fromKey) }, &toKey)
+}
+
+func (m *ConcurrentSortedMap[K, V]) Keys() List[K] {
+	var keys List[K]
+	for key := range m.Iter() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *ConcurrentSortedMap[K, V]) Values() List[V] {
+	var values List[V]
+	for _, value := range m.Iter() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (m *ConcurrentSortedMap[K, V]) Entries() []Pair[K, V] {
+	var entries []Pair[K, V]
+	for key, value := range m.Iter() {
+		entries = append(entries, PairOf(key, value))
+	}
+	return entries
+}
+
+func (m *ConcurrentSortedMap[K, V]) ForEach(consumer BiConsumer[K, V]) {
+	for key, value := range m.Iter() {
+		consumer(key, value)
+	}
+}
+
+// ToSortedMap returns a SortedMap, with the same ordering, holding the entries seen by iteration
+func (m *ConcurrentSortedMap[K, V]) ToSortedMap() *SortedMap[K, V] {
+	result := NewSortedMapFunc[K, V](m.compare)
+	for key, value := range m.Iter() {
+		result.tree.put(key, value)
+	}
+	return result
+}
+
+func (m *ConcurrentSortedMap[K, V]) ToMap() MutableMap[K, V] {
+	result := MutableMap[K, V]{}
+	for key, value := range m.Iter() {
+		result[key] = value
+	}
+	return result
+}
+
+func (m *ConcurrentSortedMap[K, V]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for key, value := range m.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v: %v", key, value))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
+
+// put inserts the key or, when replace is set, stores the value of an existing key.
+// It returns the value held afterwards and whether the key was already present.
+func (m *ConcurrentSortedMap[K, V]) put(key K, value V, replace bool) (V, bool) {
+	var preds, succs [skipListMaxLevel]*skipNode[K, V]
+	levels := randomSkipLevel()
+	for {
+		if found := m.find(key, &preds, &succs); found >= 0 {
+			existing := succs[found]
+			if existing.marked.Load() {
+				// being removed: retry until it is unlinked
+				runtime.Gosched()
+				continue
+			}
+			for !existing.fullyLinked.Load() {
+				runtime.Gosched()
+			}
+			if replace {
+				existing.value.Store(&value)
+				return value, true
+			}
+			return *existing.value.Load(), true
+		}
+
+		unlock, valid := lockPredecessors(&preds, levels, func(level int, pred *skipNode[K, V]) bool {
+			succ := succs[level]
+			return !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.next[level].Load() == succ
+		})
+		if !valid {
+			unlock()
+			continue
+		}
+
+		node := &skipNode[K, V]{key: key, next: make([]atomic.Pointer[skipNode[K, V]], levels)}
+		node.value.Store(&value)
+		for level := range levels {
+			node.next[level].Store(succs[level])
+		}
+		for level := range levels {
+			preds[level].next[level].Store(node)
+		}
+		node.fullyLinked.Store(true)
+		unlock()
+		m.size.Add(1)
+		return value, false
+	}
+}
+
+// lockPredecessors locks the distinct predecessors of the lowest levels, bottom up, and checks each with valid.
+// The returned function unlocks whatever was locked, whether or not every check passed.
+func lockPredecessors[K comparable, V any](preds *[skipListMaxLevel]*skipNode[K, V], levels int, valid func(int, *skipNode[K, V]) bool) (func(), bool) {
+	locked := make([]*skipNode[K, V], 0, levels)
+	unlock := func() {
+		for _, node := range locked {
+			node.Unlock()
+		}
+	}
+	for level := range levels {
+		pred := preds[level]
+		if len(locked) == 0 || locked[len(locked)-1] != pred {
+			pred.Lock()
+			locked = append(locked, pred)
+		}
+		if !valid(level, pred) {
+			return unlock, false
+		}
+	}
+	return unlock, true
+}
+
+// find fills, for each level, the last node before the key and the node after it, and returns
+// the highest level at which the key itself was found, or -1
+func (m *ConcurrentSortedMap[K, V]) find(key K, preds, succs *[skipListMaxLevel]*skipNode[K, V]) int {
+	found := -1
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && m.compare(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found < 0 && curr != nil && m.compare(curr.key, key) == 0 {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// ceiling returns the first live node with a key greater than or equal to the key
+func (m *ConcurrentSortedMap[K, V]) ceiling(key K) *skipNode[K, V] {
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && m.compare(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+	}
+	return m.nextLive(pred)
+}
+
+func (m *ConcurrentSortedMap[K, V]) floorEntry(key K, inclusive bool) optional.Optional[Pair[K, V]] {
+	if inclusive {
+		if node := m.ceiling(key); node != nil && m.compare(node.key, key) == 0 {
+			return skipEntryOf(node)
+		}
+	}
+	for {
+		pred := m.head
+		for level := skipListMaxLevel - 1; level >= 0; level-- {
+			for next := pred.next[level].Load(); next != nil && m.compare(next.key, key) < 0; next = pred.next[level].Load() {
+				pred = next
+			}
+		}
+		if pred == m.head {
+			return optional.Empty[Pair[K, V]]()
+		}
+		if entry := skipEntryOf(pred); !entry.IsEmpty() {
+			return entry
+		}
+		// the candidate was being inserted or removed; look again
+		runtime.Gosched()
+	}
+}
+
+func (m *ConcurrentSortedMap[K, V]) first() *skipNode[K, V] {
+	return m.nextLive(m.head)
+}
+
+// nextLive returns the first node after the given one at the bottom level that is fully linked and not removed
+func (m *ConcurrentSortedMap[K, V]) nextLive(node *skipNode[K, V]) *skipNode[K, V] {
+	for node = node.next[0].Load(); node != nil; node = node.next[0].Load() {
+		if node.fullyLinked.Load() && !node.marked.Load() {
+			return node
+		}
+	}
+	return nil
+}
+
+// scan iterates live nodes from the one returned by start until a key reaches toKey, when given
+func (m *ConcurrentSortedMap[K, V]) scan(start func() *skipNode[K, V], toKey *K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := start(); node != nil; node = m.nextLive(node) {
+			if toKey != nil && m.compare(node.key, *toKey) >= 0 {
+				return
+			}
+			if !yield(node.key, *node.value.Load()) {
+				return
+			}
+		}
+	}
+}
+
+func skipEntryOf[K comparable, V any](node *skipNode[K, V]) optional.Optional[Pair[K, V]] {
+	if node == nil || !node.fullyLinked.Load() || node.marked.Load() {
+		return optional.Empty[Pair[K, V]]()
+	}
+	return optional.Of(PairOf(node.key, *node.value.Load()))
+}
+
+// randomSkipLevel returns a level count where each extra level is half as likely as the previous one
+func randomSkipLevel() int {
+	return min(bits.TrailingZeros64(rand.Uint64())+1, skipListMaxLevel)
+}
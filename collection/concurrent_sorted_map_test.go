@@ -0,0 +1,186 @@
+package collection_test
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestConcurrentSortedMapBasics(t *testing.T) {
+	m := collection.ConcurrentSortedMapOf(collection.PairOf(30, "c"), collection.PairOf(10, "a"), collection.PairOf(20, "b"))
+
+	t.Run("keeps keys ordered", func(t *testing.T) {
+		assert.Equal(t, collection.List[int]{10, 20, 30}, m.Keys())
+		assert.Equal(t, collection.List[string]{"a", "b", "c"}, m.Values())
+		assert.Equal(t, "{10: a, 20: b, 30: c}", m.String())
+		assert.Equal(t, 3, m.Len())
+	})
+
+	t.Run("gets and replaces values", func(t *testing.T) {
+		assert.Equal(t, "b", m.Get(20).GetValue())
+		assert.True(t, m.Get(25).IsEmpty())
+		assert.Equal(t, "z", m.GetOrDefault(25, "z"))
+
+		m.Put(20, "B")
+		value, ok := m.Lookup(20)
+		assert.True(t, ok)
+		assert.Equal(t, "B", value)
+
+		value, present := m.PutIfAbsent(20, "x")
+		assert.True(t, present)
+		assert.Equal(t, "B", value)
+		value, present = m.PutIfAbsent(40, "d")
+		assert.False(t, present)
+		assert.Equal(t, "d", value)
+		assert.Equal(t, 4, m.Len())
+	})
+
+	t.Run("navigates around keys", func(t *testing.T) {
+		assert.Equal(t, collection.PairOf(10, "a"), m.FirstEntry().GetValue())
+		assert.Equal(t, collection.PairOf(40, "d"), m.LastEntry().GetValue())
+		assert.Equal(t, collection.PairOf(20, "B"), m.FloorEntry(25).GetValue())
+		assert.Equal(t, collection.PairOf(20, "B"), m.FloorEntry(20).GetValue())
+		assert.Equal(t, collection.PairOf(10, "a"), m.LowerEntry(20).GetValue())
+		assert.Equal(t, collection.PairOf(30, "c"), m.CeilingEntry(25).GetValue())
+		assert.Equal(t, collection.PairOf(30, "c"), m.HigherEntry(20).GetValue())
+		assert.True(t, m.LowerEntry(10).IsEmpty())
+		assert.True(t, m.HigherEntry(40).IsEmpty())
+	})
+
+	t.Run("scans ranges", func(t *testing.T) {
+		var keys []int
+		for key := range m.IterRange(15, 40) {
+			keys = append(keys, key)
+		}
+		assert.Equal(t, []int{20, 30}, keys)
+
+		keys = nil
+		for key := range m.IterFrom(30) {
+			keys = append(keys, key)
+		}
+		assert.Equal(t, []int{30, 40}, keys)
+		assert.Panics(t, func() { m.IterRange(5, 1) })
+	})
+
+	t.Run("removes keys", func(t *testing.T) {
+		assert.True(t, m.Remove(20))
+		assert.False(t, m.Remove(20))
+		assert.False(t, m.ContainsKey(20))
+		assert.Equal(t, collection.List[int]{10, 30, 40}, m.ToSortedMap().Keys())
+		assert.MapEqual(t, collection.MutableMap[int, string]{10: "a", 30: "c", 40: "d"}, m.ToMap())
+
+		m.Clear()
+		assert.True(t, m.IsEmpty())
+		assert.Equal(t, 0, m.Len())
+		assert.True(t, m.FirstEntry().IsEmpty())
+		assert.True(t, m.LastEntry().IsEmpty())
+	})
+}
+
+func TestConcurrentSortedMapComparator(t *testing.T) {
+	m := collection.NewConcurrentSortedMapFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	m.Put("b", 1)
+	m.Put("A", 2)
+	m.Put("B", 3)
+
+	assert.Equal(t, collection.List[string]{"A", "b"}, m.Keys())
+	assert.Equal(t, 3, m.Get("b").GetValue())
+}
+
+func TestConcurrentSortedMapMatchesSortedMap(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	m := collection.NewConcurrentSortedMap[int, int]()
+	expected := collection.NewSortedMap[int, int]()
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(500)
+		if random.Intn(3) == 0 {
+			assert.Equal(t, expected.ContainsKey(key), m.Remove(key))
+			expected.Remove(key)
+		} else {
+			m.Put(key, i)
+			expected.Put(key, i)
+		}
+	}
+
+	assert.Equal(t, expected.Entries(), m.Entries())
+	assert.Equal(t, expected.Len(), m.Len())
+	for key := -1; key <= 501; key++ {
+		assert.Equal(t, expected.FloorEntry(key).IsEmpty(), m.FloorEntry(key).IsEmpty())
+		if !expected.FloorEntry(key).IsEmpty() {
+			assert.Equal(t, expected.FloorEntry(key).GetValue(), m.FloorEntry(key).GetValue())
+		}
+		if !expected.HigherEntry(key).IsEmpty() {
+			assert.Equal(t, expected.HigherEntry(key).GetValue(), m.HigherEntry(key).GetValue())
+		}
+	}
+}
+
+func TestConcurrentSortedMapConcurrentUse(t *testing.T) {
+	m := collection.NewConcurrentSortedMap[int, int]()
+	for i := 0; i < 1000; i += 2 {
+		m.Put(i, i)
+	}
+
+	var writers, readers sync.WaitGroup
+	stop := make(chan struct{})
+	for w := 0; w < 4; w++ {
+		writers.Add(1)
+		go func(w int) {
+			defer writers.Done()
+			for i := w; i < 1000; i += 4 {
+				if i%2 == 1 {
+					m.Put(i, i)
+				} else if i%10 == 0 {
+					m.Remove(i)
+				}
+				m.PutIfAbsent(1000+i, i)
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				var keys []int
+				for key, value := range m.IterRange(100, 900) {
+					if key < 1000 && key != value {
+						t.Errorf("key %d holds %d", key, value)
+					}
+					keys = append(keys, key)
+				}
+				if !slices.IsSorted(keys) || len(slices.Compact(slices.Clone(keys))) != len(keys) {
+					t.Errorf("scan is out of order or repeats keys: %v", keys)
+				}
+				m.Get(500)
+			}
+		}()
+	}
+	writers.Wait()
+	close(stop)
+	readers.Wait()
+
+	expected := 0
+	for i := 0; i < 1000; i++ {
+		if i%2 == 1 || i%10 != 0 {
+			expected++
+		}
+	}
+	assert.Equal(t, expected+1000, m.Len())
+	assert.Equal(t, expected+1000, len(m.Keys()))
+	assert.True(t, slices.IsSorted(m.Keys()))
+	assert.False(t, m.ContainsKey(500))
+	assert.True(t, m.ContainsKey(502))
+}
@@ -0,0 +1,214 @@
+package collection
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+const dequeMinCapacity = 8
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// Pushing and popping at either end is O(1) amortized. The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buffer []T
+	head   int
+	size   int
+}
+
+// NewDeque creates an empty Deque with room for at least capacity items before growing
+func NewDeque[T any](capacity int) *Deque[T] {
+	if capacity < dequeMinCapacity {
+		capacity = dequeMinCapacity
+	}
+	return &Deque[T]{buffer: make([]T, capacity)}
+}
+
+// DequeOf creates a Deque holding the given items, the first item being the front
+func DequeOf[T any](items ...T) *Deque[T] {
+	deque := NewDeque[T](len(items))
+	for _, item := range items {
+		deque.PushBack(item)
+	}
+	return deque
+}
+
+func (d *Deque[T]) PushFront(item T) {
+	d.grow()
+	d.head = d.index(-1)
+	d.buffer[d.head] = item
+	d.size++
+}
+
+func (d *Deque[T]) PushBack(item T) {
+	d.grow()
+	d.buffer[d.index(d.size)] = item
+	d.size++
+}
+
+func (d *Deque[T]) PopFront() optional.Optional[T] {
+	if d.size == 0 {
+		return optional.Empty[T]()
+	}
+	var zero T
+	item := d.buffer[d.head]
+	d.buffer[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return optional.Of(item)
+}
+
+func (d *Deque[T]) PopBack() optional.Optional[T] {
+	if d.size == 0 {
+		return optional.Empty[T]()
+	}
+	var zero T
+	tail := d.index(d.size - 1)
+	item := d.buffer[tail]
+	d.buffer[tail] = zero
+	d.size--
+	return optional.Of(item)
+}
+
+func (d *Deque[T]) First() optional.Optional[T] {
+	if d.size == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(d.buffer[d.head])
+}
+
+func (d *Deque[T]) Last() optional.Optional[T] {
+	if d.size == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(d.buffer[d.index(d.size-1)])
+}
+
+// Get returns the item at the given position counting from the front, panicking when out of range like List.Get
+func (d *Deque[T]) Get(index int) T {
+	if index < 0 || index >= d.size {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, d.size))
+	}
+	return d.buffer[d.index(index)]
+}
+
+func (d *Deque[T]) ElementAt(index int) optional.Optional[T] {
+	if index < 0 || index >= d.size {
+		return optional.Empty[T]()
+	}
+	return optional.Of(d.buffer[d.index(index)])
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+func (d *Deque[T]) Clear() {
+	clear(d.buffer)
+	d.head = 0
+	d.size = 0
+}
+
+func (d *Deque[T]) Filter(fn Predicate[T]) Deque[T] {
+	var result Deque[T]
+	d.ForEach(func(item T) {
+		if fn(item) {
+			result.PushBack(item)
+		}
+	})
+	return result
+}
+
+func (d *Deque[T]) ForEach(fn Consumer[T]) {
+	for i := 0; i < d.size; i++ {
+		fn(d.buffer[d.index(i)])
+	}
+}
+
+func (d *Deque[T]) ForEachIndexed(fn IndexedConsumer[T]) {
+	for i := 0; i < d.size; i++ {
+		fn(i, d.buffer[d.index(i)])
+	}
+}
+
+func (d *Deque[T]) Find(fn Predicate[T]) optional.Optional[T] {
+	for i := 0; i < d.size; i++ {
+		if item := d.buffer[d.index(i)]; fn(item) {
+			return optional.Of(item)
+		}
+	}
+	return optional.Empty[T]()
+}
+
+func (d *Deque[T]) Some(fn Predicate[T]) bool {
+	return d.Find(fn).IsPresent()
+}
+
+func (d *Deque[T]) Every(fn Predicate[T]) bool {
+	return !d.Some(func(item T) bool { return !fn(item) })
+}
+
+func (d *Deque[T]) None(fn Predicate[T]) bool {
+	return !d.Some(fn)
+}
+
+func (d *Deque[T]) ToList() List[T] {
+	result := make(List[T], d.size)
+	d.ForEachIndexed(func(index int, item T) {
+		result[index] = item
+	})
+	return result
+}
+
+func (d *Deque[T]) AsSequence() Seq[T] {
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			for i := 0; i < d.size; i++ {
+				if !yield(d.buffer[d.index(i)]) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func (d *Deque[T]) String() string {
+	var str strings.Builder
+	str.WriteString("[")
+	d.ForEachIndexed(func(index int, item T) {
+		if index > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v", item))
+	})
+	str.WriteString("]")
+	return str.String()
+}
+
+// index maps a position relative to the front onto the ring buffer
+func (d *Deque[T]) index(offset int) int {
+	capacity := len(d.buffer)
+	return ((d.head+offset)%capacity + capacity) % capacity
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buffer) {
+		return
+	}
+	capacity := len(d.buffer) * 2
+	if capacity < dequeMinCapacity {
+		capacity = dequeMinCapacity
+	}
+	buffer := make([]T, capacity)
+	for i := 0; i < d.size; i++ {
+		buffer[i] = d.buffer[d.index(i)]
+	}
+	d.buffer = buffer
+	d.head = 0
+}
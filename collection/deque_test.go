@@ -0,0 +1,166 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/iterable"
+)
+
+var _ iterable.Api[int, collection.Deque[int]] = (*collection.Deque[int])(nil)
+
+func TestDequePushPop(t *testing.T) {
+	t.Run("pushes and pops at both ends", func(t *testing.T) {
+		d := collection.NewDeque[int](0)
+		d.PushBack(2)
+		d.PushBack(3)
+		d.PushFront(1)
+
+		assert.Equal(t, 3, d.Len())
+		assert.Equal(t, 1, d.PopFront().GetValue())
+		assert.Equal(t, 3, d.PopBack().GetValue())
+		assert.Equal(t, 2, d.PopBack().GetValue())
+		assert.True(t, d.IsEmpty())
+	})
+
+	t.Run("pops empty optional from empty deque", func(t *testing.T) {
+		var d collection.Deque[int]
+
+		assert.True(t, d.PopFront().IsEmpty())
+		assert.True(t, d.PopBack().IsEmpty())
+	})
+
+	t.Run("grows while wrapped around", func(t *testing.T) {
+		d := collection.NewDeque[int](0)
+		for i := 0; i < 6; i++ {
+			d.PushBack(i)
+		}
+		for i := 0; i < 4; i++ {
+			d.PopFront()
+		}
+		for i := 6; i < 30; i++ {
+			d.PushBack(i)
+		}
+		d.PushFront(3)
+
+		expected := make(collection.List[int], 0, 28)
+		for i := 3; i < 30; i++ {
+			expected = append(expected, i)
+		}
+		assert.Equal(t, expected, d.ToList())
+	})
+
+	t.Run("zero value is usable", func(t *testing.T) {
+		var d collection.Deque[string]
+		d.PushFront("a")
+
+		assert.Equal(t, "a", d.First().GetValue())
+		assert.Equal(t, "a", d.Last().GetValue())
+	})
+}
+
+func TestDequeOf(t *testing.T) {
+	d := collection.DequeOf(1, 2, 3)
+
+	assert.Equal(t, collection.List[int]{1, 2, 3}, d.ToList())
+	assert.Equal(t, 1, d.First().GetValue())
+	assert.Equal(t, 3, d.Last().GetValue())
+}
+
+func TestDequeFirstLast(t *testing.T) {
+	var d collection.Deque[int]
+
+	assert.True(t, d.First().IsEmpty())
+	assert.True(t, d.Last().IsEmpty())
+}
+
+func TestDequeGet(t *testing.T) {
+	t.Run("gets by position from the front", func(t *testing.T) {
+		d := collection.DequeOf(2, 3)
+		d.PushFront(1)
+
+		assert.Equal(t, 1, d.Get(0))
+		assert.Equal(t, 3, d.Get(2))
+		assert.Equal(t, 2, d.ElementAt(1).GetValue())
+		assert.True(t, d.ElementAt(3).IsEmpty())
+	})
+
+	t.Run("panics when out of range", func(t *testing.T) {
+		d := collection.DequeOf(1)
+
+		assert.Panics(t, func() { d.Get(1) })
+		assert.Panics(t, func() { d.Get(-1) })
+	})
+}
+
+func TestDequeClear(t *testing.T) {
+	d := collection.DequeOf(1, 2, 3)
+	d.Clear()
+
+	assert.True(t, d.IsEmpty())
+	assert.Equal(t, collection.List[int]{}, d.ToList())
+
+	d.PushBack(4)
+	assert.Equal(t, collection.List[int]{4}, d.ToList())
+}
+
+func TestDequeFilter(t *testing.T) {
+	d := collection.DequeOf(1, 2, 3, 4, 5)
+	evens := d.Filter(func(item int) bool { return item%2 == 0 })
+
+	assert.Equal(t, collection.List[int]{2, 4}, evens.ToList())
+	assert.Equal(t, 5, d.Len())
+}
+
+func TestDequeForEach(t *testing.T) {
+	d := collection.DequeOf("a", "b")
+	d.PushFront("z")
+
+	var items []string
+	d.ForEach(func(item string) {
+		items = append(items, item)
+	})
+	assert.Equal(t, []string{"z", "a", "b"}, items)
+
+	var indexes []int
+	d.ForEachIndexed(func(index int, _ string) {
+		indexes = append(indexes, index)
+	})
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+}
+
+func TestDequePredicates(t *testing.T) {
+	d := collection.DequeOf(1, 2, 3)
+
+	assert.Equal(t, 2, d.Find(func(item int) bool { return item > 1 }).GetValue())
+	assert.True(t, d.Find(func(item int) bool { return item > 3 }).IsEmpty())
+	assert.True(t, d.Some(func(item int) bool { return item == 3 }))
+	assert.True(t, d.Every(func(item int) bool { return item > 0 }))
+	assert.False(t, d.Every(func(item int) bool { return item > 1 }))
+	assert.True(t, d.None(func(item int) bool { return item > 3 }))
+}
+
+func TestDequeAsSequence(t *testing.T) {
+	d := collection.DequeOf(1, 2, 3, 4)
+	d.PushFront(0)
+
+	result := d.AsSequence().Filter(func(item int) bool { return item > 1 }).ToSlice()
+	assert.Equal(t, []int{2, 3, 4}, result)
+
+	var firstTwo []int
+	for item := range d.AsSequence().Iter() {
+		if len(firstTwo) == 2 {
+			break
+		}
+		firstTwo = append(firstTwo, item)
+	}
+	assert.Equal(t, []int{0, 1}, firstTwo)
+}
+
+func TestDequeString(t *testing.T) {
+	d := collection.DequeOf(1, 2, 3)
+
+	assert.Equal(t, "[1, 2, 3]", d.String())
+	assert.Equal(t, "[]", collection.NewDeque[int](4).String())
+}
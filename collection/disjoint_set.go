@@ -0,0 +1,146 @@
+package collection
+
+import (
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+// DisjointSet (union-find) partitions items into sets that can be merged, and tells which set an item is in.
+// With path compression and union by rank, Find and Union take nearly constant amortized time.
+// Items are added by Add or on first use in Union. The zero value is an empty DisjointSet.
+type DisjointSet[T comparable] struct {
+	index  map[T]int
+	items  []T
+	parent []int
+	rank   []uint8
+	sets   int
+}
+
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{index: map[T]int{}}
+}
+
+// DisjointSetOf creates a DisjointSet holding each item in a set of its own
+func DisjointSetOf[T comparable](items ...T) *DisjointSet[T] {
+	d := NewDisjointSet[T]()
+	for _, item := range items {
+		d.Add(item)
+	}
+	return d
+}
+
+// Add puts the item in a new set of its own, returning false if it was already present
+func (d *DisjointSet[T]) Add(item T) bool {
+	if _, ok := d.index[item]; ok {
+		return false
+	}
+	if d.index == nil {
+		d.index = map[T]int{}
+	}
+	d.index[item] = len(d.items)
+	d.parent = append(d.parent, len(d.items))
+	d.items = append(d.items, item)
+	d.rank = append(d.rank, 0)
+	d.sets++
+	return true
+}
+
+func (d *DisjointSet[T]) Contains(item T) bool {
+	_, ok := d.index[item]
+	return ok
+}
+
+// Find returns the representative item of the set holding the item, or empty if the item is unknown.
+// Two items are in the same set exactly when they have the same representative.
+func (d *DisjointSet[T]) Find(item T) optional.Optional[T] {
+	i, ok := d.index[item]
+	if !ok {
+		return optional.Empty[T]()
+	}
+	return optional.Of(d.items[d.root(i)])
+}
+
+// Union merges the sets holding a and b, adding either item first if it is unknown.
+// It returns false if they were already in the same set.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	d.Add(a)
+	d.Add(b)
+	rootA, rootB := d.root(d.index[a]), d.root(d.index[b])
+	if rootA == rootB {
+		return false
+	}
+
+	switch {
+	case d.rank[rootA] < d.rank[rootB]:
+		d.parent[rootA] = rootB
+	case d.rank[rootA] > d.rank[rootB]:
+		d.parent[rootB] = rootA
+	default:
+		d.parent[rootB] = rootA
+		d.rank[rootA]++
+	}
+	d.sets--
+	return true
+}
+
+// Connected reports whether both items are known and in the same set
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	i, okA := d.index[a]
+	j, okB := d.index[b]
+	return okA && okB && d.root(i) == d.root(j)
+}
+
+// SetCount returns the number of disjoint sets
+func (d *DisjointSet[T]) SetCount() int {
+	return d.sets
+}
+
+// Len returns the number of items
+func (d *DisjointSet[T]) Len() int {
+	return len(d.items)
+}
+
+func (d *DisjointSet[T]) IsEmpty() bool {
+	return len(d.items) == 0
+}
+
+// Component returns the items in the same set as the item, empty if the item is unknown
+func (d *DisjointSet[T]) Component(item T) Set[T] {
+	result := Set[T]{}
+	i, ok := d.index[item]
+	if !ok {
+		return result
+	}
+	root := d.root(i)
+	for j, other := range d.items {
+		if d.root(j) == root {
+			result.Add(other)
+		}
+	}
+	return result
+}
+
+// Components returns every set, in the order their first item was added
+func (d *DisjointSet[T]) Components() List[Set[T]] {
+	position := map[int]int{}
+	var components List[Set[T]]
+	for i, item := range d.items {
+		root := d.root(i)
+		at, ok := position[root]
+		if !ok {
+			at = len(components)
+			position[root] = at
+			components = append(components, Set[T]{})
+		}
+		components[at].Add(item)
+	}
+	return components
+}
+
+// root finds the root of i, halving the path on the way
+func (d *DisjointSet[T]) root(i int) int {
+	for d.parent[i] != i {
+		d.parent[i] = d.parent[d.parent[i]]
+		i = d.parent[i]
+	}
+	return i
+}
@@ -0,0 +1,82 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestDisjointSetUnion(t *testing.T) {
+	t.Run("merges sets and tracks their count", func(t *testing.T) {
+		d := collection.DisjointSetOf("a", "b", "c", "d")
+		assert.Equal(t, 4, d.SetCount())
+
+		assert.True(t, d.Union("a", "b"))
+		assert.True(t, d.Union("c", "d"))
+		assert.False(t, d.Union("b", "a"))
+		assert.Equal(t, 2, d.SetCount())
+
+		assert.True(t, d.Connected("a", "b"))
+		assert.False(t, d.Connected("a", "c"))
+		assert.True(t, d.Union("b", "d"))
+		assert.True(t, d.Connected("a", "c"))
+		assert.Equal(t, 1, d.SetCount())
+		assert.Equal(t, 4, d.Len())
+	})
+
+	t.Run("adds unknown items on union", func(t *testing.T) {
+		var d collection.DisjointSet[int]
+		assert.True(t, d.IsEmpty())
+		assert.True(t, d.Union(1, 2))
+		assert.False(t, d.Add(1))
+		assert.True(t, d.Add(3))
+
+		assert.Equal(t, 3, d.Len())
+		assert.Equal(t, 2, d.SetCount())
+		assert.True(t, d.Contains(2))
+		assert.False(t, d.Contains(9))
+		assert.False(t, d.Connected(1, 9))
+		assert.False(t, d.Connected(9, 9))
+	})
+}
+
+func TestDisjointSetFind(t *testing.T) {
+	d := collection.NewDisjointSet[string]()
+	d.Union("x", "y")
+	d.Union("y", "z")
+	d.Add("w")
+
+	root := d.Find("x").GetValue()
+	assert.Equal(t, root, d.Find("y").GetValue())
+	assert.Equal(t, root, d.Find("z").GetValue())
+	assert.Equal(t, "w", d.Find("w").GetValue())
+	assert.True(t, d.Find("missing").IsEmpty())
+}
+
+func TestDisjointSetComponents(t *testing.T) {
+	accounts := collection.NewDisjointSet[string]()
+	for _, shared := range [][2]string{{"ana", "bob"}, {"cid", "dee"}, {"bob", "eve"}} {
+		accounts.Union(shared[0], shared[1])
+	}
+	accounts.Add("fay")
+
+	assert.Equal(t, collection.List[collection.Set[string]]{
+		{"ana": {}, "bob": {}, "eve": {}},
+		{"cid": {}, "dee": {}},
+		{"fay": {}},
+	}, accounts.Components())
+	assert.MapEqual(t, collection.Set[string]{"cid": {}, "dee": {}}, accounts.Component("dee"))
+	assert.Equal(t, 0, accounts.Component("zed").Len())
+}
+
+func TestDisjointSetLongChains(t *testing.T) {
+	d := collection.NewDisjointSet[int]()
+	for i := 1; i < 10000; i++ {
+		d.Union(i-1, i)
+	}
+
+	assert.Equal(t, 1, d.SetCount())
+	assert.True(t, d.Connected(0, 9999))
+	assert.Equal(t, 10000, d.Components()[0].Len())
+}
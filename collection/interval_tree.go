@@ -0,0 +1,242 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/marlonbarreto-git/gollections/sequence"
+	. "github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+// Interval is the half-open range of keys from Start, included, to End, excluded.
+// Half-open intervals that touch, like [1, 3) and [3, 5), do not overlap but are adjacent.
+// An interval whose End is not after its Start is empty.
+type Interval[K cmp.Ordered] struct {
+	Start K
+	End   K
+}
+
+func IntervalOf[K cmp.Ordered](start, end K) Interval[K] {
+	return Interval[K]{Start: start, End: end}
+}
+
+func (i Interval[K]) IsEmpty() bool {
+	return i.Start >= i.End
+}
+
+// Contains reports whether the key is in the interval
+func (i Interval[K]) Contains(key K) bool {
+	return i.Start <= key && key < i.End
+}
+
+// Overlaps reports whether the intervals share at least one key
+func (i Interval[K]) Overlaps(other Interval[K]) bool {
+	return i.Start < other.End && other.Start < i.End && !i.IsEmpty() && !other.IsEmpty()
+}
+
+func (i Interval[K]) String() string {
+	return fmt.Sprintf("[%v, %v)", i.Start, i.End)
+}
+
+func compareIntervals[K cmp.Ordered](a, b Interval[K]) int {
+	if c := cmp.Compare(a.Start, b.Start); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.End, b.End)
+}
+
+// IntervalTree maps intervals to values and finds every interval overlapping a range or containing a key in
+// O(log n + m) time, for m matches. It is the AVL tree behind SortedMap, ordered by start then end and augmented
+// so each node also tracks the greatest end in its subtree. Each interval holds one value, like the keys of a map.
+type IntervalTree[K cmp.Ordered, V any] struct {
+	entries *tree[Interval[K], intervalEntry[K, V]]
+}
+
+// intervalEntry is the value stored in the tree for an interval, with the greatest end of its subtree
+type intervalEntry[K cmp.Ordered, V any] struct {
+	value  V
+	maxEnd K
+}
+
+func NewIntervalTree[K cmp.Ordered, V any]() *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{}
+}
+
+func updateMaxEnd[K cmp.Ordered, V any](n *treeNode[Interval[K], intervalEntry[K, V]]) {
+	n.value.maxEnd = n.key.End
+	if n.left != nil {
+		n.value.maxEnd = max(n.value.maxEnd, n.left.value.maxEnd)
+	}
+	if n.right != nil {
+		n.value.maxEnd = max(n.value.maxEnd, n.right.value.maxEnd)
+	}
+}
+
+// Put maps the interval to the value, replacing the value it had. It panics if the interval ends before it starts.
+func (t *IntervalTree[K, V]) Put(interval Interval[K], value V) {
+	if interval.End < interval.Start {
+		panic(fmt.Sprintf("interval %v ends before it starts", interval))
+	}
+	t.init()
+	t.entries.put(interval, intervalEntry[K, V]{value: value})
+}
+
+func (t *IntervalTree[K, V]) Get(interval Interval[K]) optional.Optional[V] {
+	if node := t.node(interval); node != nil {
+		return optional.Of(node.value.value)
+	}
+	return optional.Empty[V]()
+}
+
+// Lookup returns the value of the interval and whether it is present
+func (t *IntervalTree[K, V]) Lookup(interval Interval[K]) (V, bool) {
+	if node := t.node(interval); node != nil {
+		return node.value.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *IntervalTree[K, V]) ContainsKey(interval Interval[K]) bool {
+	return t.node(interval) != nil
+}
+
+// Remove deletes the interval, returning false if it was not present
+func (t *IntervalTree[K, V]) Remove(interval Interval[K]) bool {
+	return t.entries != nil && t.entries.remove(interval) != nil
+}
+
+func (t *IntervalTree[K, V]) Clear() {
+	if t.entries != nil {
+		t.entries.root = nil
+	}
+}
+
+func (t *IntervalTree[K, V]) Len() int {
+	return t.root().subtreeSize()
+}
+
+func (t *IntervalTree[K, V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Overlapping lazily returns the entries whose interval overlaps the given one, ordered by interval
+func (t *IntervalTree[K, V]) Overlapping(interval Interval[K]) sequence.Seq[Pair[Interval[K], V]] {
+	return sequence.FromIter(func(yield func(Pair[Interval[K], V]) bool) {
+		if interval.IsEmpty() {
+			return
+		}
+		searchIntervals(t.root(), func(n *treeNode[Interval[K], intervalEntry[K, V]]) (bool, bool) {
+			return n.value.maxEnd > interval.Start, n.key.Start < interval.End
+		}, func(n *treeNode[Interval[K], intervalEntry[K, V]]) bool {
+			return !n.key.Overlaps(interval) || yield(PairOf(n.key, n.value.value))
+		})
+	})
+}
+
+// Stab lazily returns the entries whose interval contains the key, ordered by interval
+func (t *IntervalTree[K, V]) Stab(key K) sequence.Seq[Pair[Interval[K], V]] {
+	return sequence.FromIter(func(yield func(Pair[Interval[K], V]) bool) {
+		searchIntervals(t.root(), func(n *treeNode[Interval[K], intervalEntry[K, V]]) (bool, bool) {
+			return n.value.maxEnd > key, n.key.Start <= key
+		}, func(n *treeNode[Interval[K], intervalEntry[K, V]]) bool {
+			return !n.key.Contains(key) || yield(PairOf(n.key, n.value.value))
+		})
+	})
+}
+
+// AnyOverlapping reports whether an interval of the tree overlaps the given one, such as a conflicting booking
+func (t *IntervalTree[K, V]) AnyOverlapping(interval Interval[K]) bool {
+	return t.Overlapping(interval).Any(func(Pair[Interval[K], V]) bool { return true })
+}
+
+// Iter iterates the entries ordered by interval start, then end
+func (t *IntervalTree[K, V]) Iter() iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		searchIntervals(t.root(), func(*treeNode[Interval[K], intervalEntry[K, V]]) (bool, bool) {
+			return true, true
+		}, func(n *treeNode[Interval[K], intervalEntry[K, V]]) bool {
+			return yield(n.key, n.value.value)
+		})
+	}
+}
+
+func (t *IntervalTree[K, V]) Intervals() List[Interval[K]] {
+	intervals := make(List[Interval[K]], 0, t.Len())
+	for interval := range t.Iter() {
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+func (t *IntervalTree[K, V]) Entries() []Pair[Interval[K], V] {
+	entries := make([]Pair[Interval[K], V], 0, t.Len())
+	for interval, value := range t.Iter() {
+		entries = append(entries, PairOf(interval, value))
+	}
+	return entries
+}
+
+func (t *IntervalTree[K, V]) ForEach(consumer BiConsumer[Interval[K], V]) {
+	for interval, value := range t.Iter() {
+		consumer(interval, value)
+	}
+}
+
+func (t *IntervalTree[K, V]) String() string {
+	var builder strings.Builder
+	builder.WriteString("{")
+	first := true
+	for interval, value := range t.Iter() {
+		if !first {
+			builder.WriteString(", ")
+		}
+		first = false
+		builder.WriteString(fmt.Sprintf("%v: %v", interval, value))
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+// init creates the tree on first use, so the zero value is an empty IntervalTree
+func (t *IntervalTree[K, V]) init() {
+	if t.entries == nil {
+		t.entries = newAugmentedTree(compareIntervals[K], updateMaxEnd[K, V])
+	}
+}
+
+func (t *IntervalTree[K, V]) root() *treeNode[Interval[K], intervalEntry[K, V]] {
+	if t.entries == nil {
+		return nil
+	}
+	return t.entries.root
+}
+
+func (t *IntervalTree[K, V]) node(interval Interval[K]) *treeNode[Interval[K], intervalEntry[K, V]] {
+	if t.entries == nil {
+		return nil
+	}
+	return t.entries.get(interval)
+}
+
+// searchIntervals visits the nodes in order until visit returns false. prune tells, for a subtree, whether it may
+// hold matches at all, and whether its root and right side may.
+func searchIntervals[K cmp.Ordered, V any](n *treeNode[Interval[K], intervalEntry[K, V]], prune func(*treeNode[Interval[K], intervalEntry[K, V]]) (bool, bool), visit func(*treeNode[Interval[K], intervalEntry[K, V]]) bool) bool {
+	if n == nil {
+		return true
+	}
+	matches, right := prune(n)
+	if !matches {
+		return true
+	}
+	if !searchIntervals(n.left, prune, visit) {
+		return false
+	}
+	if !right {
+		return true
+	}
+	return visit(n) && searchIntervals(n.right, prune, visit)
+}
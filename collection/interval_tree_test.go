@@ -0,0 +1,115 @@
+package collection_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestInterval(t *testing.T) {
+	i := collection.IntervalOf(1, 5)
+	assert.True(t, i.Contains(1))
+	assert.False(t, i.Contains(5))
+	assert.True(t, i.Overlaps(collection.IntervalOf(4, 9)))
+	assert.False(t, i.Overlaps(collection.IntervalOf(5, 9)))
+	assert.False(t, i.Overlaps(collection.IntervalOf(3, 3)))
+	assert.True(t, collection.IntervalOf(2, 2).IsEmpty())
+	assert.Equal(t, "[1, 5)", i.String())
+}
+
+func TestIntervalTreeQueries(t *testing.T) {
+	meetings := collection.NewIntervalTree[int, string]()
+	meetings.Put(collection.IntervalOf(900, 1000), "standup")
+	meetings.Put(collection.IntervalOf(930, 1100), "review")
+	meetings.Put(collection.IntervalOf(1300, 1400), "lunch talk")
+	meetings.Put(collection.IntervalOf(1000, 1030), "sync")
+
+	t.Run("finds overlapping intervals", func(t *testing.T) {
+		assert.Equal(t, []collection.Pair[collection.Interval[int], string]{
+			collection.PairOf(collection.IntervalOf(930, 1100), "review"),
+			collection.PairOf(collection.IntervalOf(1000, 1030), "sync"),
+		}, meetings.Overlapping(collection.IntervalOf(1000, 1200)).ToSlice())
+		assert.True(t, meetings.AnyOverlapping(collection.IntervalOf(1330, 1500)))
+		assert.False(t, meetings.AnyOverlapping(collection.IntervalOf(1100, 1300)))
+		assert.Equal(t, 0, meetings.Overlapping(collection.IntervalOf(1000, 1000)).Count())
+	})
+
+	t.Run("stabs a point", func(t *testing.T) {
+		assert.Equal(t, []collection.Pair[collection.Interval[int], string]{
+			collection.PairOf(collection.IntervalOf(900, 1000), "standup"),
+			collection.PairOf(collection.IntervalOf(930, 1100), "review"),
+		}, meetings.Stab(945).ToSlice())
+		assert.Equal(t, 0, meetings.Stab(1200).Count())
+	})
+
+	t.Run("iterates in interval order", func(t *testing.T) {
+		assert.Equal(t, collection.List[collection.Interval[int]]{
+			collection.IntervalOf(900, 1000),
+			collection.IntervalOf(930, 1100),
+			collection.IntervalOf(1000, 1030),
+			collection.IntervalOf(1300, 1400),
+		}, meetings.Intervals())
+		assert.Equal(t, "{[900, 1000): standup, [930, 1100): review, [1000, 1030): sync, [1300, 1400): lunch talk}", meetings.String())
+	})
+}
+
+func TestIntervalTreeUpdates(t *testing.T) {
+	var tree collection.IntervalTree[float64, int]
+	tree.Put(collection.IntervalOf(0.5, 1.5), 1)
+	tree.Put(collection.IntervalOf(0.5, 1.5), 2)
+	assert.Equal(t, 1, tree.Len())
+	assert.Equal(t, 2, tree.Get(collection.IntervalOf(0.5, 1.5)).GetValue())
+
+	value, ok := tree.Lookup(collection.IntervalOf(0.5, 1.5))
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.True(t, tree.ContainsKey(collection.IntervalOf(0.5, 1.5)))
+	assert.False(t, tree.ContainsKey(collection.IntervalOf(0.5, 2)))
+
+	assert.True(t, tree.Remove(collection.IntervalOf(0.5, 1.5)))
+	assert.False(t, tree.Remove(collection.IntervalOf(0.5, 1.5)))
+	assert.True(t, tree.IsEmpty())
+	assert.True(t, tree.Get(collection.IntervalOf(0.5, 1.5)).IsEmpty())
+
+	assert.Panics(t, func() { tree.Put(collection.IntervalOf(2.0, 1.0), 0) })
+}
+
+func TestIntervalTreeMatchesBruteForce(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	tree := collection.NewIntervalTree[int, int]()
+	stored := map[collection.Interval[int]]int{}
+	for i := 0; i < 2000; i++ {
+		start := random.Intn(1000)
+		interval := collection.IntervalOf(start, start+random.Intn(50))
+		if random.Intn(4) == 0 {
+			assert.Equal(t, tree.Remove(interval), stored[interval] != 0)
+			delete(stored, interval)
+			continue
+		}
+		tree.Put(interval, i+1)
+		stored[interval] = i + 1
+	}
+	assert.Equal(t, len(stored), tree.Len())
+
+	for i := 0; i < 200; i++ {
+		start := random.Intn(1000)
+		query := collection.IntervalOf(start, start+random.Intn(100))
+		expected := 0
+		for interval := range stored {
+			if interval.Overlaps(query) {
+				expected++
+			}
+		}
+		assert.Equal(t, expected, tree.Overlapping(query).Count())
+
+		stabbed := 0
+		for interval := range stored {
+			if interval.Contains(start) {
+				stabbed++
+			}
+		}
+		assert.Equal(t, stabbed, tree.Stab(start).Count())
+	}
+}
@@ -0,0 +1,362 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+// LinkedMap is a map that remembers the order its keys were inserted in, or optionally the order
+// they were last accessed in. Lookups, insertions and removals stay O(1).
+// Iteration, Keys, String and JSON encoding follow that order. The zero value is an empty insertion-ordered map.
+// A LinkedMap must not be copied once used, since its entries link back into it: hold it by pointer,
+// for example as a *LinkedMap struct field.
+type LinkedMap[K comparable, V any] struct {
+	entries     map[K]*linkedEntry[K, V]
+	root        linkedEntry[K, V]
+	accessOrder bool
+}
+
+type linkedEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *linkedEntry[K, V]
+}
+
+// NewLinkedMap creates an empty LinkedMap that keeps insertion order.
+// Re-putting an existing key keeps its original position.
+func NewLinkedMap[K comparable, V any]() *LinkedMap[K, V] {
+	return new(LinkedMap[K, V]).init()
+}
+
+// NewAccessOrderedLinkedMap creates an empty LinkedMap that moves a key to the end whenever
+// it is read or written, so the first entry is always the least recently used one
+func NewAccessOrderedLinkedMap[K comparable, V any]() *LinkedMap[K, V] {
+	m := NewLinkedMap[K, V]()
+	m.accessOrder = true
+	return m
+}
+
+// LinkedMapOf creates a LinkedMap holding the given pairs in order
+func LinkedMapOf[K comparable, V any](pairs ...Pair[K, V]) *LinkedMap[K, V] {
+	m := NewLinkedMap[K, V]()
+	m.PutAll(pairs...)
+	return m
+}
+
+func MapLinkedValues[K comparable, V, NV any](original *LinkedMap[K, V], fn func(K, V) NV) *LinkedMap[K, NV] {
+	result := NewLinkedMap[K, NV]()
+	result.accessOrder = original.accessOrder
+	for key, value := range original.Iter() {
+		result.Put(key, fn(key, value))
+	}
+	return result
+}
+
+func (m *LinkedMap[K, V]) Put(key K, value V) {
+	if entry, ok := m.entries[key]; ok {
+		entry.value = value
+		m.touch(entry)
+		return
+	}
+	if m.entries == nil {
+		m.init()
+	}
+	entry := &linkedEntry[K, V]{key: key, value: value}
+	m.entries[key] = entry
+	m.link(entry)
+}
+
+func (m *LinkedMap[K, V]) PutAll(pairs ...Pair[K, V]) {
+	for _, pair := range pairs {
+		m.Put(pair.First(), pair.Second())
+	}
+}
+
+func (m *LinkedMap[K, V]) Get(key K) optional.Optional[V] {
+	if entry, ok := m.entries[key]; ok {
+		m.touch(entry)
+		return optional.Of(entry.value)
+	}
+	return optional.Empty[V]()
+}
+
+// Lookup returns the value for the key and whether it was present, counting it as an access.
+// Unlike Get it also reports zero values, which an Optional treats as empty.
+func (m *LinkedMap[K, V]) Lookup(key K) (value V, ok bool) {
+	entry, ok := m.entries[key]
+	if !ok {
+		return
+	}
+	m.touch(entry)
+	return entry.value, true
+}
+
+// Peek is like Lookup but does not count as an access, leaving access order untouched
+func (m *LinkedMap[K, V]) Peek(key K) (value V, ok bool) {
+	entry, ok := m.entries[key]
+	if !ok {
+		return
+	}
+	return entry.value, true
+}
+
+func (m *LinkedMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if entry, ok := m.entries[key]; ok {
+		m.touch(entry)
+		return entry.value
+	}
+	return defaultValue
+}
+
+func (m *LinkedMap[K, V]) GetOrPut(key K, defaultFn func() V) V {
+	if entry, ok := m.entries[key]; ok {
+		m.touch(entry)
+		return entry.value
+	}
+	value := defaultFn()
+	m.Put(key, value)
+	return value
+}
+
+func (m *LinkedMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+func (m *LinkedMap[K, V]) ContainsValue(value V) bool {
+	for _, v := range m.Iter() {
+		var valAny any = value
+		var vAny any = v
+		if valAny == vAny {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *LinkedMap[K, V]) Remove(key K) {
+	if entry, ok := m.entries[key]; ok {
+		delete(m.entries, key)
+		m.unlink(entry)
+	}
+}
+
+func (m *LinkedMap[K, V]) Clear() {
+	m.init()
+}
+
+func (m *LinkedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+func (m *LinkedMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+// FirstEntry returns the eldest entry, the least recently accessed one for access-ordered maps
+func (m *LinkedMap[K, V]) FirstEntry() optional.Optional[Pair[K, V]] {
+	if m.IsEmpty() {
+		return optional.Empty[Pair[K, V]]()
+	}
+	return optional.Of(PairOf(m.root.next.key, m.root.next.value))
+}
+
+// LastEntry returns the youngest entry, the most recently accessed one for access-ordered maps
+func (m *LinkedMap[K, V]) LastEntry() optional.Optional[Pair[K, V]] {
+	if m.IsEmpty() {
+		return optional.Empty[Pair[K, V]]()
+	}
+	return optional.Of(PairOf(m.root.prev.key, m.root.prev.value))
+}
+
+// Iter returns an iterator over the entries in order. Iterating does not count as an access.
+func (m *LinkedMap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.IsEmpty() {
+			return
+		}
+		for entry := m.root.next; entry != &m.root; {
+			next := entry.next
+			if !yield(entry.key, entry.value) {
+				return
+			}
+			entry = next
+		}
+	}
+}
+
+// Backward returns an iterator over the entries in reverse order
+func (m *LinkedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.IsEmpty() {
+			return
+		}
+		for entry := m.root.prev; entry != &m.root; {
+			prev := entry.prev
+			if !yield(entry.key, entry.value) {
+				return
+			}
+			entry = prev
+		}
+	}
+}
+
+func (m *LinkedMap[K, V]) Keys() List[K] {
+	keys := make(List[K], 0, m.Len())
+	for key := range m.Iter() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *LinkedMap[K, V]) Values() List[V] {
+	values := make(List[V], 0, m.Len())
+	for _, value := range m.Iter() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (m *LinkedMap[K, V]) Entries() []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, m.Len())
+	for key, value := range m.Iter() {
+		entries = append(entries, PairOf(key, value))
+	}
+	return entries
+}
+
+func (m *LinkedMap[K, V]) ForEach(consumer function.BiConsumer[K, V]) {
+	for key, value := range m.Iter() {
+		consumer(key, value)
+	}
+}
+
+func (m *LinkedMap[K, V]) Filter(predicate function.BiPredicate[K, V]) *LinkedMap[K, V] {
+	result := m.empty()
+	for key, value := range m.Iter() {
+		if predicate(key, value) {
+			result.Put(key, value)
+		}
+	}
+	return result
+}
+
+func (m *LinkedMap[K, V]) FilterKeys(predicate func(K) bool) *LinkedMap[K, V] {
+	return m.Filter(func(key K, _ V) bool { return predicate(key) })
+}
+
+func (m *LinkedMap[K, V]) FilterValues(predicate func(V) bool) *LinkedMap[K, V] {
+	return m.Filter(func(_ K, value V) bool { return predicate(value) })
+}
+
+func (m *LinkedMap[K, V]) Count(predicate function.BiPredicate[K, V]) (count int) {
+	for key, value := range m.Iter() {
+		if predicate(key, value) {
+			count++
+		}
+	}
+
+	return
+}
+
+func (m *LinkedMap[K, V]) Any(predicate function.BiPredicate[K, V]) bool {
+	for key, value := range m.Iter() {
+		if predicate(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *LinkedMap[K, V]) All(predicate function.BiPredicate[K, V]) bool {
+	for key, value := range m.Iter() {
+		if !predicate(key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *LinkedMap[K, V]) None(predicate function.BiPredicate[K, V]) bool {
+	return !m.Any(predicate)
+}
+
+// Merge puts every entry of other into the map. Keys already present keep their position
+// and take the value from other, new keys are appended in the order other holds them.
+func (m *LinkedMap[K, V]) Merge(other *LinkedMap[K, V]) {
+	for key, value := range other.Iter() {
+		m.Put(key, value)
+	}
+}
+
+func (m *LinkedMap[K, V]) Copy() *LinkedMap[K, V] {
+	return m.Filter(func(K, V) bool { return true })
+}
+
+func (m *LinkedMap[K, V]) ToMap() MutableMap[K, V] {
+	result := make(MutableMap[K, V], m.Len())
+	for key, value := range m.Iter() {
+		result[key] = value
+	}
+	return result
+}
+
+func (m *LinkedMap[K, V]) String() string {
+	bytes, err := m.MarshalJSON()
+	if err != nil {
+		return m.stringFallthrough()
+	}
+
+	return string(bytes)
+}
+
+func (m *LinkedMap[K, V]) stringFallthrough() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for key, value := range m.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v: %v", key, value))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
+
+func (m *LinkedMap[K, V]) empty() *LinkedMap[K, V] {
+	result := NewLinkedMap[K, V]()
+	result.accessOrder = m.accessOrder
+	return result
+}
+
+func (m *LinkedMap[K, V]) init() *LinkedMap[K, V] {
+	m.entries = map[K]*linkedEntry[K, V]{}
+	m.root.prev, m.root.next = &m.root, &m.root
+	return m
+}
+
+func (m *LinkedMap[K, V]) touch(entry *linkedEntry[K, V]) {
+	if m.accessOrder && entry != m.root.prev {
+		m.unlink(entry)
+		m.link(entry)
+	}
+}
+
+func (m *LinkedMap[K, V]) link(entry *linkedEntry[K, V]) {
+	entry.prev, entry.next = m.root.prev, &m.root
+	m.root.prev.next = entry
+	m.root.prev = entry
+}
+
+func (m *LinkedMap[K, V]) unlink(entry *linkedEntry[K, V]) {
+	entry.prev.next = entry.next
+	entry.next.prev = entry.prev
+}
@@ -0,0 +1,136 @@
+package collection
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalJSON encodes the map as a JSON object whose members follow the map order.
+// Keys are encoded like encoding/json does for Go maps: strings, integers and encoding.TextMarshaler.
+// Like every LinkedMap method it needs a pointer, so a LinkedMap field held by value in a struct encoded
+// by value comes out as {}; use a *LinkedMap field.
+func (m *LinkedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+
+	first := true
+	for key, value := range m.Iter() {
+		keyText, err := linkedKeyText(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedKey, _ := json.Marshal(keyText)
+		encodedValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buffer.WriteByte(',')
+		}
+		buffer.Write(encodedKey)
+		buffer.WriteByte(':')
+		buffer.Write(encodedValue)
+		first = false
+	}
+
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object, keeping their order.
+// JSON null leaves the map unchanged, as encoding/json does for Go maps.
+func (m *LinkedMap[K, V]) UnmarshalJSON(data []byte) (err error) {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if err = expectDelim(decoder, '{'); err != nil {
+		return
+	}
+
+	m.Clear()
+	for decoder.More() {
+		var token json.Token
+		if token, err = decoder.Token(); err != nil {
+			return
+		}
+
+		var key K
+		if err = parseLinkedKey(token.(string), &key); err != nil {
+			return
+		}
+
+		var value V
+		if err = decoder.Decode(&value); err != nil {
+			return
+		}
+		m.Put(key, value)
+	}
+
+	return expectDelim(decoder, '}')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("json: expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+func linkedKeyText(key any) (string, error) {
+	switch k := key.(type) {
+	case string:
+		return k, nil
+	case encoding.TextMarshaler:
+		text, err := k.MarshalText()
+		return string(text), err
+	}
+
+	value := reflect.ValueOf(key)
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("json: unsupported key type %T", key)
+}
+
+func parseLinkedKey(text string, target any) error {
+	if unmarshaler, ok := target.(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	value := reflect.ValueOf(target).Elem()
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(parsed)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		parsed, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetUint(parsed)
+		return nil
+	}
+	return fmt.Errorf("json: unsupported key type %v", value.Type())
+}
@@ -0,0 +1,146 @@
+package collection_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+type level int
+
+func (l level) MarshalText() ([]byte, error) {
+	return []byte([]string{"low", "high"}[l]), nil
+}
+
+func (l *level) UnmarshalText(text []byte) error {
+	if string(text) == "high" {
+		*l = 1
+	}
+	return nil
+}
+
+func TestLinkedMapMarshalJSON(t *testing.T) {
+	t.Run("keeps member order", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf("name", "app"), collection.PairOf("env", "prod"))
+
+		bytes, err := json.Marshal(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"name":"app","env":"prod"}`, string(bytes))
+	})
+
+	t.Run("encodes integer keys", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf(2, true), collection.PairOf(1, false))
+
+		bytes, err := json.Marshal(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"2":true,"1":false}`, string(bytes))
+	})
+
+	t.Run("encodes text marshaler keys", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf(level(1), 10))
+
+		bytes, err := json.Marshal(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"high":10}`, string(bytes))
+	})
+
+	t.Run("fails on unsupported keys", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf(1.5, 1))
+
+		_, err := json.Marshal(m)
+		assert.Error(t, err)
+	})
+
+	t.Run("encodes empty map", func(t *testing.T) {
+		bytes, err := json.Marshal(collection.NewLinkedMap[string, int]())
+		assert.NoError(t, err)
+		assert.Equal(t, `{}`, string(bytes))
+	})
+}
+
+func TestLinkedMapUnmarshalJSON(t *testing.T) {
+	t.Run("keeps member order", func(t *testing.T) {
+		var m collection.LinkedMap[string, int]
+
+		err := json.Unmarshal([]byte(`{"zeta": 1, "alpha": 2, "mid": 3}`), &m)
+		assert.NoError(t, err)
+		assert.Equal(t, collection.List[string]{"zeta", "alpha", "mid"}, m.Keys())
+		assert.Equal(t, collection.List[int]{1, 2, 3}, m.Values())
+	})
+
+	t.Run("decodes nested values and integer keys", func(t *testing.T) {
+		var m collection.LinkedMap[uint8, []string]
+
+		err := json.Unmarshal([]byte(`{"2": ["a"], "1": ["b", "c"]}`), &m)
+		assert.NoError(t, err)
+		assert.Equal(t, collection.List[uint8]{2, 1}, m.Keys())
+		assert.Equal(t, []string{"b", "c"}, m.Get(1).GetValue())
+	})
+
+	t.Run("decodes text unmarshaler keys", func(t *testing.T) {
+		var m collection.LinkedMap[level, int]
+
+		err := json.Unmarshal([]byte(`{"high": 1, "low": 0}`), &m)
+		assert.NoError(t, err)
+		assert.Equal(t, collection.List[level]{1, 0}, m.Keys())
+	})
+
+	t.Run("replaces previous contents", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf("old", 1))
+
+		err := json.Unmarshal([]byte(`{"new": 2}`), m)
+		assert.NoError(t, err)
+		assert.Equal(t, collection.List[string]{"new"}, m.Keys())
+	})
+
+	t.Run("round trips", func(t *testing.T) {
+		original := collection.LinkedMapOf(collection.PairOf("b", 1), collection.PairOf("a", 2))
+		bytes, _ := json.Marshal(original)
+
+		var decoded collection.LinkedMap[string, int]
+		assert.NoError(t, json.Unmarshal(bytes, &decoded))
+		assert.Equal(t, original.Entries(), decoded.Entries())
+	})
+
+	t.Run("ignores null", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf("kept", 1))
+		assert.NoError(t, json.Unmarshal([]byte(`null`), m))
+		assert.Equal(t, collection.List[string]{"kept"}, m.Keys())
+
+		var event struct {
+			Name string
+			Tags collection.LinkedMap[string, int]
+		}
+		assert.NoError(t, json.Unmarshal([]byte(`{"Name": "deploy", "Tags": null}`), &event))
+		assert.Equal(t, "deploy", event.Name)
+		assert.True(t, event.Tags.IsEmpty())
+	})
+
+	t.Run("round trips as an optional struct field", func(t *testing.T) {
+		type event struct {
+			Tags *collection.LinkedMap[string, int] `json:",omitempty"`
+		}
+		bytes, err := json.Marshal(event{Tags: collection.LinkedMapOf(collection.PairOf("b", 1), collection.PairOf("a", 2))})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"Tags":{"b":1,"a":2}}`, string(bytes))
+
+		var decoded event
+		assert.NoError(t, json.Unmarshal([]byte(`{"Tags": null}`), &decoded))
+		assert.True(t, decoded.Tags == nil)
+	})
+
+	t.Run("fails on invalid input", func(t *testing.T) {
+		var m collection.LinkedMap[string, int]
+
+		assert.Error(t, json.Unmarshal([]byte(`[1, 2]`), &m))
+		assert.Error(t, json.Unmarshal([]byte(`{"a": "text"}`), &m))
+	})
+
+	t.Run("fails on invalid keys", func(t *testing.T) {
+		var m collection.LinkedMap[int, int]
+
+		assert.Error(t, json.Unmarshal([]byte(`{"one": 1}`), &m))
+	})
+}
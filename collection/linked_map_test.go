@@ -0,0 +1,220 @@
+package collection_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestLinkedMapInsertionOrder(t *testing.T) {
+	t.Run("keeps keys in insertion order", func(t *testing.T) {
+		m := collection.NewLinkedMap[string, int]()
+		m.Put("zeta", 1)
+		m.Put("alpha", 2)
+		m.Put("mid", 3)
+
+		assert.Equal(t, collection.List[string]{"zeta", "alpha", "mid"}, m.Keys())
+		assert.Equal(t, collection.List[int]{1, 2, 3}, m.Values())
+	})
+
+	t.Run("re-putting a key keeps its position", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf("a", 1), collection.PairOf("b", 2))
+		m.Put("a", 10)
+
+		assert.Equal(t, collection.List[string]{"a", "b"}, m.Keys())
+		assert.Equal(t, 10, m.Get("a").GetValue())
+	})
+
+	t.Run("removed keys are appended when put back", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf("a", 1), collection.PairOf("b", 2))
+		m.Remove("a")
+		m.Remove("missing")
+		m.Put("a", 1)
+
+		assert.Equal(t, collection.List[string]{"b", "a"}, m.Keys())
+	})
+
+	t.Run("zero value is usable", func(t *testing.T) {
+		var m collection.LinkedMap[string, int]
+
+		assert.True(t, m.IsEmpty())
+		assert.Equal(t, collection.List[string]{}, m.Keys())
+		m.Put("a", 1)
+		assert.Equal(t, 1, m.Len())
+	})
+}
+
+func TestLinkedMapAccessOrder(t *testing.T) {
+	m := collection.NewAccessOrderedLinkedMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	m.Get("a")
+	assert.Equal(t, collection.List[string]{"b", "c", "a"}, m.Keys())
+
+	m.Put("b", 20)
+	assert.Equal(t, collection.List[string]{"c", "a", "b"}, m.Keys())
+
+	m.GetOrDefault("c", 0)
+	m.GetOrPut("a", func() int { return 0 })
+	assert.Equal(t, collection.List[string]{"b", "c", "a"}, m.Keys())
+
+	m.ContainsKey("b")
+	value, ok := m.Peek("b")
+	assert.Equal(t, 20, value)
+	assert.True(t, ok)
+	_, ok = m.Peek("z")
+	assert.False(t, ok)
+	assert.Equal(t, "b", m.FirstEntry().GetValue().First())
+	assert.Equal(t, "a", m.LastEntry().GetValue().First())
+}
+
+func TestLinkedMapGet(t *testing.T) {
+	m := collection.LinkedMapOf(collection.PairOf("a", 1))
+
+	assert.True(t, m.Get("b").IsEmpty())
+	assert.Equal(t, 5, m.GetOrDefault("b", 5))
+	assert.True(t, m.ContainsKey("a"))
+	assert.True(t, m.ContainsValue(1))
+	assert.False(t, m.ContainsValue(2))
+
+	calls := 0
+	assert.Equal(t, 7, m.GetOrPut("b", func() int { calls++; return 7 }))
+	assert.Equal(t, 7, m.GetOrPut("b", func() int { calls++; return 8 }))
+	assert.Equal(t, 1, calls)
+}
+
+func TestLinkedMapLookup(t *testing.T) {
+	m := collection.NewAccessOrderedLinkedMap[string, string]()
+	m.Put("empty", "")
+	m.Put("other", "x")
+
+	value, ok := m.Lookup("empty")
+	assert.Equal(t, "", value)
+	assert.True(t, ok)
+	assert.Equal(t, collection.List[string]{"other", "empty"}, m.Keys())
+
+	_, ok = m.Lookup("missing")
+	assert.False(t, ok)
+}
+
+func TestLinkedMapFirstLastEntry(t *testing.T) {
+	m := collection.NewLinkedMap[string, int]()
+
+	assert.True(t, m.FirstEntry().IsEmpty())
+	assert.True(t, m.LastEntry().IsEmpty())
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+	assert.Equal(t, 1, m.FirstEntry().GetValue().Second())
+	assert.Equal(t, 2, m.LastEntry().GetValue().Second())
+}
+
+func TestLinkedMapIteration(t *testing.T) {
+	m := collection.LinkedMapOf(collection.PairOf(3, "c"), collection.PairOf(1, "a"), collection.PairOf(2, "b"))
+
+	t.Run("iterates in order", func(t *testing.T) {
+		var keys []int
+		for key := range m.Iter() {
+			keys = append(keys, key)
+		}
+		assert.Equal(t, []int{3, 1, 2}, keys)
+	})
+
+	t.Run("iterates backwards", func(t *testing.T) {
+		var values []string
+		for _, value := range m.Backward() {
+			values = append(values, value)
+		}
+		assert.Equal(t, []string{"b", "a", "c"}, values)
+	})
+
+	t.Run("allows removing the current entry", func(t *testing.T) {
+		copied := m.Copy()
+		for key := range copied.Iter() {
+			if key != 2 {
+				copied.Remove(key)
+			}
+		}
+		assert.Equal(t, collection.List[int]{2}, copied.Keys())
+		assert.Equal(t, 3, m.Len())
+	})
+
+	t.Run("entries follow order", func(t *testing.T) {
+		entries := m.Entries()
+		assert.Equal(t, 3, entries[0].First())
+		assert.Equal(t, "b", entries[2].Second())
+	})
+}
+
+func TestLinkedMapFunctional(t *testing.T) {
+	m := collection.LinkedMapOf(
+		collection.PairOf("d", 4),
+		collection.PairOf("a", 1),
+		collection.PairOf("c", 3),
+		collection.PairOf("b", 2),
+	)
+	isEven := func(_ string, value int) bool { return value%2 == 0 }
+
+	assert.Equal(t, collection.List[string]{"d", "b"}, m.Filter(isEven).Keys())
+	assert.Equal(t, collection.List[string]{"a", "b"}, m.FilterKeys(func(key string) bool { return key < "c" }).Keys())
+	assert.Equal(t, collection.List[int]{4, 3}, m.FilterValues(func(value int) bool { return value > 2 }).Values())
+	assert.Equal(t, 2, m.Count(isEven))
+	assert.True(t, m.Any(isEven))
+	assert.False(t, m.All(isEven))
+	assert.False(t, m.None(isEven))
+
+	var visited []string
+	m.ForEach(func(key string, _ int) {
+		visited = append(visited, key)
+	})
+	assert.Equal(t, []string{"d", "a", "c", "b"}, visited)
+
+	assert.MapEqual(t, map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}, m.ToMap())
+}
+
+func TestMapLinkedValues(t *testing.T) {
+	m := collection.LinkedMapOf(collection.PairOf("b", 2), collection.PairOf("a", 1))
+	mapped := collection.MapLinkedValues(m, func(key string, value int) string {
+		return strings.Repeat(key, value)
+	})
+
+	assert.Equal(t, collection.List[string]{"b", "a"}, mapped.Keys())
+	assert.Equal(t, collection.List[string]{"bb", "a"}, mapped.Values())
+}
+
+func TestLinkedMapMerge(t *testing.T) {
+	base := collection.LinkedMapOf(collection.PairOf("host", "localhost"), collection.PairOf("port", "80"))
+	override := collection.LinkedMapOf(collection.PairOf("tls", "on"), collection.PairOf("port", "443"))
+
+	base.Merge(override)
+
+	assert.Equal(t, collection.List[string]{"host", "port", "tls"}, base.Keys())
+	assert.Equal(t, "443", base.Get("port").GetValue())
+}
+
+func TestLinkedMapClear(t *testing.T) {
+	m := collection.LinkedMapOf(collection.PairOf("a", 1))
+	m.Clear()
+
+	assert.True(t, m.IsEmpty())
+	m.Put("b", 2)
+	assert.Equal(t, collection.List[string]{"b"}, m.Keys())
+}
+
+func TestLinkedMapString(t *testing.T) {
+	t.Run("is stable and ordered", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf("z", 1), collection.PairOf("a", 2))
+
+		assert.Equal(t, `{"z":1,"a":2}`, m.String())
+	})
+
+	t.Run("falls back when values are not encodable", func(t *testing.T) {
+		m := collection.LinkedMapOf(collection.PairOf("fn", func() {}))
+
+		assert.True(t, strings.HasPrefix(m.String(), "{fn: "))
+	})
+}
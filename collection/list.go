@@ -9,6 +9,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/marlonbarreto-git/gollections/sequence"
 	. "github.com/marlonbarreto-git/gollections/tomove/function"
 	"github.com/marlonbarreto-git/gollections/tomove/optional"
 	"github.com/marlonbarreto-git/gollections/tomove/types"
@@ -107,8 +108,9 @@ func (list List[T]) Filter(fn Predicate[T]) (filteredItems List[T]) {
 
 }
 
+// Append returns a new list with the item added at the end. It never writes to the receiver's backing array.
 func (list List[T]) Append(item T) List[T] {
-	return append(list, item)
+	return append(list[:len(list):len(list)], item)
 }
 
 func (list *List[T]) Add(item T) *List[T] {
@@ -584,6 +586,11 @@ func (s Seq[T]) ToSlice() []T {
 	return result
 }
 
+// All returns the index-item pairs of the list, for `for i, item := range list.All().Iter()` style pipelines
+func (list List[T]) All() sequence.Seq2[int, T] {
+	return sequence.FromIter2(slices.All(list))
+}
+
 func (list List[T]) AsSequence() Seq[T] {
 	return Seq[T]{
 		iter: func(yield func(T) bool) {
@@ -961,11 +968,11 @@ func FirstNotNullOf[T, R any](list List[T], fn func(T) *R) optional.Optional[R]
 }
 
 func (list List[T]) Plus(element T) List[T] {
-	return append(list, element)
+	return list.Append(element)
 }
 
 func (list List[T]) PlusAll(elements List[T]) List[T] {
-	return append(list, elements...)
+	return append(list[:len(list):len(list)], elements...)
 }
 
 func (list List[T]) Minus(element T) List[T] {
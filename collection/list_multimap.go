@@ -0,0 +1,195 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// ListMultimap maps each key to a list of values, keeping duplicates and insertion order within a key.
+// Values are compared with ==, so pointers match only the same pointer.
+// Len counts every key-value entry. The zero value is an empty multimap.
+type ListMultimap[K, V comparable] struct {
+	buckets map[K]List[V]
+	size    int
+}
+
+func NewListMultimap[K, V comparable]() *ListMultimap[K, V] {
+	return &ListMultimap[K, V]{buckets: map[K]List[V]{}}
+}
+
+func ListMultimapOf[K, V comparable](pairs ...Pair[K, V]) *ListMultimap[K, V] {
+	m := NewListMultimap[K, V]()
+	for _, pair := range pairs {
+		m.Put(pair.First(), pair.Second())
+	}
+	return m
+}
+
+// ListMultimapFrom copies an existing grouping, such as the result of GroupBy, into a multimap
+func ListMultimapFrom[K, V comparable](groups map[K]List[V]) *ListMultimap[K, V] {
+	m := NewListMultimap[K, V]()
+	for key, values := range groups {
+		m.PutAll(key, values...)
+	}
+	return m
+}
+
+func (m *ListMultimap[K, V]) Put(key K, value V) {
+	m.PutAll(key, value)
+}
+
+func (m *ListMultimap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	if m.buckets == nil {
+		m.buckets = map[K]List[V]{}
+	}
+	m.buckets[key] = append(m.buckets[key], values...)
+	m.size += len(values)
+}
+
+// Get returns a copy of the values for the key, empty if there are none
+func (m *ListMultimap[K, V]) Get(key K) List[V] {
+	return slices.Clone(m.buckets[key])
+}
+
+func (m *ListMultimap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.buckets[key]
+	return ok
+}
+
+func (m *ListMultimap[K, V]) ContainsEntry(key K, value V) bool {
+	return slices.Contains(m.buckets[key], value)
+}
+
+// RemoveValue removes the first occurrence of the value under the key and reports whether there was one
+func (m *ListMultimap[K, V]) RemoveValue(key K, value V) bool {
+	values := m.buckets[key]
+	index := slices.Index(values, value)
+	if index < 0 {
+		return false
+	}
+
+	if len(values) == 1 {
+		delete(m.buckets, key)
+	} else {
+		m.buckets[key] = slices.Delete(values, index, index+1)
+	}
+	m.size--
+	return true
+}
+
+// RemoveAll removes the key and returns the values it had
+func (m *ListMultimap[K, V]) RemoveAll(key K) List[V] {
+	values := m.buckets[key]
+	delete(m.buckets, key)
+	m.size -= len(values)
+	return values
+}
+
+func (m *ListMultimap[K, V]) Clear() {
+	clear(m.buckets)
+	m.size = 0
+}
+
+// Len returns the number of key-value entries
+func (m *ListMultimap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *ListMultimap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m *ListMultimap[K, V]) Keys() Set[K] {
+	keys := make(Set[K], len(m.buckets))
+	for key := range m.buckets {
+		keys.Add(key)
+	}
+	return keys
+}
+
+// KeysWithCount returns the keys in a Bag, each counted once per value it holds
+func (m *ListMultimap[K, V]) KeysWithCount() *Bag[K] {
+	keys := NewBag[K]()
+	for key, values := range m.buckets {
+		keys.Add(key, len(values))
+	}
+	return keys
+}
+
+func (m *ListMultimap[K, V]) Values() List[V] {
+	values := make(List[V], 0, m.size)
+	for _, v := range m.Iter() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Entries flattens the multimap into one pair per key-value entry, in no particular order
+func (m *ListMultimap[K, V]) Entries() []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, m.size)
+	for k, v := range m.Iter() {
+		entries = append(entries, PairOf(k, v))
+	}
+	return entries
+}
+
+// Iter returns an iterator over every key-value entry. Keys come in no particular order.
+func (m *ListMultimap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range m.buckets {
+			for _, value := range values {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *ListMultimap[K, V]) ForEach(fn func(K, V)) {
+	for k, v := range m.Iter() {
+		fn(k, v)
+	}
+}
+
+// AsMap returns a copy of the grouping as a map from each key to its values
+func (m *ListMultimap[K, V]) AsMap() MutableMap[K, List[V]] {
+	result := make(MutableMap[K, List[V]], len(m.buckets))
+	for key, values := range m.buckets {
+		result[key] = slices.Clone(values)
+	}
+	return result
+}
+
+// InvertListMultimap returns a copy of the multimap mapping each value to the keys it was stored under, a key
+// appearing once per entry. The order of the keys under a value is unspecified, like the order of Iter.
+// Later changes to either multimap do not show in the other. It is a function to match InvertSetMultimap.
+func InvertListMultimap[K, V comparable](m *ListMultimap[K, V]) *ListMultimap[V, K] {
+	inverse := NewListMultimap[V, K]()
+	for k, v := range m.Iter() {
+		inverse.Put(v, k)
+	}
+	return inverse
+}
+
+func (m *ListMultimap[K, V]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for key, values := range m.buckets {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v: %v", key, values))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
@@ -0,0 +1,102 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/list"
+)
+
+func TestListMultimapPut(t *testing.T) {
+	t.Run("keeps duplicates in insertion order", func(t *testing.T) {
+		var m collection.ListMultimap[string, int]
+		m.Put("a", 1)
+		m.PutAll("a", 2, 1)
+		m.PutAll("b")
+
+		assert.Equal(t, collection.List[int]{1, 2, 1}, m.Get("a"))
+		assert.Equal(t, 3, m.Len())
+		assert.False(t, m.ContainsKey("b"))
+		assert.Equal(t, 0, len(m.Get("b")))
+	})
+
+	t.Run("returns copies from Get", func(t *testing.T) {
+		m := collection.ListMultimapOf(collection.PairOf("a", 1))
+		values := m.Get("a")
+		values[0] = 9
+
+		assert.Equal(t, collection.List[int]{1}, m.Get("a"))
+	})
+
+	t.Run("continues an existing grouping", func(t *testing.T) {
+		groups := collection.GroupBy(list.Of("apple", "avocado", "banana"), func(s string) byte { return s[0] })
+		m := collection.ListMultimapFrom(groups)
+		m.Put('b', "blueberry")
+
+		assert.Equal(t, collection.List[string]{"banana", "blueberry"}, m.Get('b'))
+		assert.Equal(t, collection.List[string]{"banana"}, groups['b'])
+		assert.Equal(t, 4, m.Len())
+	})
+}
+
+func TestListMultimapRemove(t *testing.T) {
+	m := collection.ListMultimapOf(
+		collection.PairOf("a", 1), collection.PairOf("a", 2), collection.PairOf("a", 1), collection.PairOf("b", 3),
+	)
+
+	assert.True(t, m.RemoveValue("a", 1))
+	assert.Equal(t, collection.List[int]{2, 1}, m.Get("a"))
+	assert.False(t, m.RemoveValue("a", 7))
+	assert.True(t, m.RemoveValue("b", 3))
+	assert.False(t, m.ContainsKey("b"))
+	assert.Equal(t, 2, m.Len())
+
+	assert.Equal(t, collection.List[int]{2, 1}, m.RemoveAll("a"))
+	assert.True(t, m.IsEmpty())
+
+	m.Put("c", 1)
+	m.Clear()
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestListMultimapComparesPointersByIdentity(t *testing.T) {
+	type task struct{ name string }
+	first, lookalike := &task{"build"}, &task{"build"}
+	m := collection.ListMultimapOf(collection.PairOf("ci", first), collection.PairOf("ci", lookalike))
+
+	assert.True(t, m.ContainsEntry("ci", first))
+	assert.False(t, m.ContainsEntry("ci", &task{"build"}))
+	assert.True(t, m.RemoveValue("ci", lookalike))
+	assert.True(t, m.Get("ci")[0] == first)
+}
+
+func TestListMultimapViews(t *testing.T) {
+	m := collection.ListMultimapOf(
+		collection.PairOf("a", 1), collection.PairOf("a", 2), collection.PairOf("b", 1),
+	)
+
+	assert.True(t, m.ContainsEntry("a", 2))
+	assert.False(t, m.ContainsEntry("b", 2))
+	assert.MapEqual(t, collection.Set[string]{"a": {}, "b": {}}, m.Keys())
+	assert.MapEqual(t, map[string]int{"a": 2, "b": 1}, m.KeysWithCount().ToMap())
+	assert.Equal(t, 3, len(m.Values()))
+	assert.Equal(t, 3, len(m.Entries()))
+	assert.Contains(t, m.Entries(), collection.PairOf("a", 2))
+	assert.Equal(t, collection.List[int]{1, 2}, m.AsMap()["a"])
+	assert.Equal(t, "{b: [1]}", collection.ListMultimapOf(collection.PairOf("b", 1)).String())
+
+	count := 0
+	m.ForEach(func(string, int) { count++ })
+	assert.Equal(t, 3, count)
+
+	inverse := collection.InvertListMultimap(m)
+	assert.Equal(t, 2, len(inverse.Get(1)))
+	assert.Equal(t, collection.List[string]{"a"}, inverse.Get(2))
+
+	// the inverse is a copy
+	m.Put("c", 3)
+	inverse.Put(9, "z")
+	assert.False(t, inverse.ContainsKey(3))
+	assert.False(t, m.ContainsKey("z"))
+}
@@ -211,6 +211,16 @@ func TestAppend(t *testing.T) {
 		result := list.Of[int]().Append(1)
 		assert.Equal(t, collection.List[int]{1}, result)
 	})
+
+	t.Run("does not share spare capacity between results", func(t *testing.T) {
+		base := make(collection.List[int], 2, 10)
+		first := base.Append(1)
+		second := base.Plus(2)
+		third := base.PlusAll(list.Of(3))
+		assert.Equal(t, collection.List[int]{0, 0, 1}, first)
+		assert.Equal(t, collection.List[int]{0, 0, 2}, second)
+		assert.Equal(t, collection.List[int]{0, 0, 3}, third)
+	})
 }
 
 func TestListAdd(t *testing.T) {
@@ -1804,3 +1814,19 @@ func TestPipeTransform(t *testing.T) {
 		assert.Equal(t, 3, len(backToList))
 	})
 }
+
+func TestListAll(t *testing.T) {
+	names := collection.List[string]{"ana", "bob", "cid"}
+
+	var indexes []int
+	var items []string
+	for i, name := range names.All().Iter() {
+		indexes = append(indexes, i)
+		items = append(items, name)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, []string{"ana", "bob", "cid"}, items)
+
+	odd := names.All().Filter(func(i int, _ string) bool { return i%2 == 1 }).Values().ToSlice()
+	assert.Equal(t, []string{"bob"}, odd)
+}
@@ -4,11 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/marlonbarreto-git/gollections/sequence"
 	"github.com/marlonbarreto-git/gollections/tomove/function"
 	"github.com/marlonbarreto-git/gollections/tomove/optional"
 	"github.com/marlonbarreto-git/gollections/tomove/types"
 )
 
+// MutableMap is a plain Go map with helper methods. Like any map it is not safe for concurrent use
+// when at least one goroutine writes; use ConcurrentMap for that.
 type MutableMap[K comparable, V any] map[K]V
 
 func Map[K, NK comparable, V, NV any](original MutableMap[K, V], fn func(K, V) (NK, NV)) MutableMap[NK, NV] {
@@ -244,6 +247,12 @@ func (m MutableMap[K, V]) None(predicate function.BiPredicate[K, V]) bool {
 	return !m.Any(predicate)
 }
 
+// AsSeq2 returns the entries of the map as a key-value sequence, in the map's unspecified iteration order.
+// It plays the role of an All iterator, a name already taken by the All predicate.
+func (m MutableMap[K, V]) AsSeq2() sequence.Seq2[K, V] {
+	return sequence.FromMap(m)
+}
+
 func (m MutableMap[K, V]) ToSet() Set[K] {
 	result := make(Set[K], len(m))
 	for k := range m {
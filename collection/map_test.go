@@ -6,6 +6,7 @@ import (
 	"github.com/marlonbarreto-git/gollections/collection"
 	assert "github.com/marlonbarreto-git/gollections/internal/testing"
 	maps "github.com/marlonbarreto-git/gollections/map"
+	"github.com/marlonbarreto-git/gollections/sequence"
 )
 
 func TestMapOf(t *testing.T) {
@@ -394,3 +395,18 @@ func TestMapTakeUnless(t *testing.T) {
 		assert.True(t, result.IsEmpty())
 	})
 }
+
+func TestMapAsSeq2(t *testing.T) {
+	stock := maps.Of(collection.PairOf("apple", 3), collection.PairOf("pear", 0), collection.PairOf("plum", 7))
+
+	var inStock collection.MutableMap[string, int] = sequence.ToMap(stock.AsSeq2().Filter(func(_ string, count int) bool {
+		return count > 0
+	}))
+	assert.MapEqual(t, collection.MutableMap[string, int]{"apple": 3, "plum": 7}, inStock)
+
+	total := 0
+	for _, count := range stock.AsSeq2().Iter() {
+		total += count
+	}
+	assert.Equal(t, 10, total)
+}
@@ -0,0 +1,186 @@
+package collection
+
+import (
+	"github.com/marlonbarreto-git/gollections/sequence"
+	. "github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+// PriorityQueue is a binary heap ordered by a Comparator.
+// The item that would sort first with the comparator (as in List.Sorted) is the one returned by Peek and Pop.
+type PriorityQueue[T any] struct {
+	heap       []*QueueHandle[T]
+	comparator Comparator[T]
+}
+
+// QueueHandle references an item pushed into a PriorityQueue so its priority can be updated or the item removed later
+type QueueHandle[T any] struct {
+	item  T
+	index int
+	queue *PriorityQueue[T]
+}
+
+func NewPriorityQueue[T any](comparator Comparator[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{comparator: comparator}
+}
+
+// Value returns the item referenced by the handle
+func (h *QueueHandle[T]) Value() T {
+	return h.item
+}
+
+// IsQueued reports whether the item is still in its queue
+func (h *QueueHandle[T]) IsQueued() bool {
+	return h.queue != nil
+}
+
+func (pq *PriorityQueue[T]) Push(item T) *QueueHandle[T] {
+	handle := &QueueHandle[T]{item: item, index: len(pq.heap), queue: pq}
+	pq.heap = append(pq.heap, handle)
+	pq.up(handle.index)
+	return handle
+}
+
+// PushAll pushes the items, rebuilding the heap once, and returns their handles in the order of items
+func (pq *PriorityQueue[T]) PushAll(items List[T]) []*QueueHandle[T] {
+	handles := make([]*QueueHandle[T], len(items))
+	for i, item := range items {
+		handles[i] = &QueueHandle[T]{item: item, index: len(pq.heap), queue: pq}
+		pq.heap = append(pq.heap, handles[i])
+	}
+	for i := len(pq.heap)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+	return handles
+}
+
+func (pq *PriorityQueue[T]) Peek() optional.Optional[T] {
+	if len(pq.heap) == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(pq.heap[0].item)
+}
+
+func (pq *PriorityQueue[T]) Pop() optional.Optional[T] {
+	if len(pq.heap) == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(pq.removeAt(0).item)
+}
+
+// Update replaces the item referenced by the handle and restores the heap order.
+// It returns false when the handle no longer belongs to this queue.
+func (pq *PriorityQueue[T]) Update(handle *QueueHandle[T], item T) bool {
+	if handle.queue != pq {
+		return false
+	}
+	handle.item = item
+	pq.fix(handle.index)
+	return true
+}
+
+// Remove deletes the item referenced by the handle.
+// It returns false when the handle no longer belongs to this queue.
+func (pq *PriorityQueue[T]) Remove(handle *QueueHandle[T]) bool {
+	if handle.queue != pq {
+		return false
+	}
+	pq.removeAt(handle.index)
+	return true
+}
+
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.heap)
+}
+
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.heap) == 0
+}
+
+func (pq *PriorityQueue[T]) Clear() {
+	for _, handle := range pq.heap {
+		handle.queue = nil
+	}
+	pq.heap = nil
+}
+
+// ToList returns the queued items in priority order without modifying the queue
+func (pq *PriorityQueue[T]) ToList() List[T] {
+	result := make(List[T], len(pq.heap))
+	for i, handle := range pq.heap {
+		result[i] = handle.item
+	}
+	return result.Sorted(pq.comparator)
+}
+
+// Drain returns a sequence that pops items in priority order as it is iterated.
+// Items not reached before the iteration stops remain in the queue.
+func (pq *PriorityQueue[T]) Drain() sequence.Seq[T] {
+	return sequence.FromIter(func(yield func(T) bool) {
+		for len(pq.heap) > 0 {
+			if !yield(pq.removeAt(0).item) {
+				return
+			}
+		}
+	})
+}
+
+func (pq *PriorityQueue[T]) removeAt(index int) *QueueHandle[T] {
+	last := len(pq.heap) - 1
+	removed := pq.heap[index]
+	pq.swap(index, last)
+	pq.heap[last] = nil
+	pq.heap = pq.heap[:last]
+	if index < last {
+		pq.fix(index)
+	}
+	removed.queue = nil
+	removed.index = -1
+	return removed
+}
+
+func (pq *PriorityQueue[T]) fix(index int) {
+	if !pq.down(index) {
+		pq.up(index)
+	}
+}
+
+func (pq *PriorityQueue[T]) up(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !pq.less(index, parent) {
+			return
+		}
+		pq.swap(index, parent)
+		index = parent
+	}
+}
+
+func (pq *PriorityQueue[T]) down(index int) bool {
+	start := index
+	for {
+		child := 2*index + 1
+		if child >= len(pq.heap) {
+			break
+		}
+		if right := child + 1; right < len(pq.heap) && pq.less(right, child) {
+			child = right
+		}
+		if !pq.less(child, index) {
+			break
+		}
+		pq.swap(index, child)
+		index = child
+	}
+	return index > start
+}
+
+func (pq *PriorityQueue[T]) less(i, j int) bool {
+	return pq.comparator(pq.heap[i].item, pq.heap[j].item) < 0
+}
+
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.heap[i], pq.heap[j] = pq.heap[j], pq.heap[i]
+	pq.heap[i].index = i
+	pq.heap[j].index = j
+}
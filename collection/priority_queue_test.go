@@ -0,0 +1,170 @@
+package collection_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+type task struct {
+	name     string
+	priority int
+}
+
+func byPriority(a, b task) int {
+	return cmp.Compare(a.priority, b.priority)
+}
+
+func TestPriorityQueuePushPop(t *testing.T) {
+	t.Run("pops items in comparator order", func(t *testing.T) {
+		pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+		for _, item := range []int{5, 1, 4, 2, 3} {
+			pq.Push(item)
+		}
+
+		var popped []int
+		for !pq.IsEmpty() {
+			popped = append(popped, pq.Pop().GetValue())
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, popped)
+	})
+
+	t.Run("reversed comparator pops largest first", func(t *testing.T) {
+		pq := collection.NewPriorityQueue(func(a, b int) int { return cmp.Compare(b, a) })
+		pq.PushAll(collection.List[int]{3, 9, 1})
+
+		assert.Equal(t, 9, pq.Pop().GetValue())
+		assert.Equal(t, 3, pq.Pop().GetValue())
+	})
+
+	t.Run("pops empty optional from empty queue", func(t *testing.T) {
+		pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+
+		assert.True(t, pq.Pop().IsEmpty())
+		assert.True(t, pq.Peek().IsEmpty())
+	})
+}
+
+func TestPriorityQueuePeek(t *testing.T) {
+	pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+	pq.PushAll(collection.List[int]{7, 2, 9})
+
+	assert.Equal(t, 2, pq.Peek().GetValue())
+	assert.Equal(t, 3, pq.Len())
+}
+
+func TestPriorityQueuePushAll(t *testing.T) {
+	pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+	pq.Push(4)
+	pq.PushAll(collection.List[int]{8, 3, 6, 1, 7})
+
+	assert.Equal(t, collection.List[int]{1, 3, 4, 6, 7, 8}, pq.ToList())
+	assert.Equal(t, 6, pq.Len())
+
+	t.Run("returns handles in input order", func(t *testing.T) {
+		pq := collection.NewPriorityQueue(byPriority)
+		handles := pq.PushAll(collection.List[task]{{"a", 5}, {"b", 2}, {"c", 8}})
+		assert.Equal(t, "a", handles[0].Value().name)
+		assert.Equal(t, "c", handles[2].Value().name)
+
+		assert.True(t, pq.Update(handles[2], task{"c", 0}))
+		assert.True(t, pq.Remove(handles[1]))
+		assert.Equal(t, "c", pq.Pop().GetValue().name)
+		assert.Equal(t, "a", pq.Pop().GetValue().name)
+		assert.True(t, pq.IsEmpty())
+	})
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	t.Run("moves item up when its priority increases", func(t *testing.T) {
+		pq := collection.NewPriorityQueue(byPriority)
+		pq.Push(task{"a", 1})
+		pq.Push(task{"b", 2})
+		handle := pq.Push(task{"c", 3})
+
+		assert.True(t, pq.Update(handle, task{"c", 0}))
+		assert.Equal(t, "c", pq.Peek().GetValue().name)
+		assert.Equal(t, 0, handle.Value().priority)
+	})
+
+	t.Run("moves item down when its priority decreases", func(t *testing.T) {
+		pq := collection.NewPriorityQueue(byPriority)
+		handle := pq.Push(task{"a", 1})
+		pq.Push(task{"b", 2})
+		pq.Push(task{"c", 3})
+
+		pq.Update(handle, task{"a", 10})
+
+		names := make([]string, 0, 3)
+		for item := range pq.Drain().Iter() {
+			names = append(names, item.name)
+		}
+		assert.Equal(t, []string{"b", "c", "a"}, names)
+	})
+
+	t.Run("rejects handles no longer queued", func(t *testing.T) {
+		pq := collection.NewPriorityQueue(byPriority)
+		handle := pq.Push(task{"a", 1})
+		pq.Pop()
+
+		assert.False(t, handle.IsQueued())
+		assert.False(t, pq.Update(handle, task{"a", 2}))
+		assert.True(t, pq.IsEmpty())
+	})
+
+	t.Run("rejects handles from another queue", func(t *testing.T) {
+		pq := collection.NewPriorityQueue(byPriority)
+		other := collection.NewPriorityQueue(byPriority)
+		handle := other.Push(task{"a", 1})
+
+		assert.False(t, pq.Update(handle, task{"a", 2}))
+		assert.False(t, pq.Remove(handle))
+	})
+}
+
+func TestPriorityQueueRemove(t *testing.T) {
+	pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+	handles := make([]*collection.QueueHandle[int], 0, 6)
+	for _, item := range []int{6, 2, 5, 1, 4, 3} {
+		handles = append(handles, pq.Push(item))
+	}
+
+	assert.True(t, pq.Remove(handles[1]))
+	assert.True(t, pq.Remove(handles[3]))
+	assert.False(t, pq.Remove(handles[3]))
+
+	assert.Equal(t, []int{3, 4, 5, 6}, pq.Drain().ToSlice())
+}
+
+func TestPriorityQueueClear(t *testing.T) {
+	pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+	handle := pq.Push(1)
+	pq.Push(2)
+
+	pq.Clear()
+
+	assert.True(t, pq.IsEmpty())
+	assert.False(t, handle.IsQueued())
+}
+
+func TestPriorityQueueDrain(t *testing.T) {
+	t.Run("drains lazily in priority order", func(t *testing.T) {
+		pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+		pq.PushAll(collection.List[int]{4, 1, 3, 2})
+
+		drain := pq.Drain()
+		assert.Equal(t, 4, pq.Len())
+
+		assert.Equal(t, []int{1, 2}, drain.Take(2).ToSlice())
+		assert.Equal(t, 2, pq.Len())
+		assert.Equal(t, 3, pq.Peek().GetValue())
+	})
+
+	t.Run("drains empty queue", func(t *testing.T) {
+		pq := collection.NewPriorityQueue[int](cmp.Compare[int])
+
+		assert.Equal(t, []int{}, pq.Drain().ToSlice())
+	})
+}
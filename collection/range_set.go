@@ -0,0 +1,228 @@
+package collection
+
+import (
+	"cmp"
+	"iter"
+	"strings"
+)
+
+// RangeSet is a set of keys stored as disjoint half-open ranges. Added ranges that overlap or touch
+// existing ones are coalesced with them, and removing a range may split one in two, so the set always
+// holds the fewest ranges covering its keys. Ranges are kept in an AVL tree by start, making
+// Contains O(log n). The zero value is an empty RangeSet.
+type RangeSet[K cmp.Ordered] struct {
+	ranges *tree[K, K]
+}
+
+func NewRangeSet[K cmp.Ordered]() *RangeSet[K] {
+	return &RangeSet[K]{ranges: newTree[K, K](cmp.Compare[K])}
+}
+
+func RangeSetOf[K cmp.Ordered](ranges ...Interval[K]) *RangeSet[K] {
+	s := NewRangeSet[K]()
+	for _, r := range ranges {
+		s.Add(r)
+	}
+	return s
+}
+
+// Add adds the keys of the range. Empty ranges add nothing.
+func (s *RangeSet[K]) Add(r Interval[K]) {
+	if r.IsEmpty() {
+		return
+	}
+	s.init()
+	for _, existing := range s.touching(r) {
+		s.ranges.remove(existing.Start)
+		r.Start = min(r.Start, existing.Start)
+		r.End = max(r.End, existing.End)
+	}
+	s.ranges.put(r.Start, r.End)
+}
+
+// Remove removes the keys of the range, keeping the parts of existing ranges outside it
+func (s *RangeSet[K]) Remove(r Interval[K]) {
+	if r.IsEmpty() || s.ranges == nil {
+		return
+	}
+	for _, existing := range s.overlapping(r) {
+		s.ranges.remove(existing.Start)
+		if existing.Start < r.Start {
+			s.ranges.put(existing.Start, r.Start)
+		}
+		if r.End < existing.End {
+			s.ranges.put(r.End, existing.End)
+		}
+	}
+}
+
+func (s *RangeSet[K]) Contains(key K) bool {
+	_, ok := s.RangeContaining(key)
+	return ok
+}
+
+// Encloses reports whether every key of the range is in the set
+func (s *RangeSet[K]) Encloses(r Interval[K]) bool {
+	if r.IsEmpty() {
+		return true
+	}
+	enclosing, ok := s.RangeContaining(r.Start)
+	return ok && r.End <= enclosing.End
+}
+
+// Overlaps reports whether any key of the range is in the set
+func (s *RangeSet[K]) Overlaps(r Interval[K]) bool {
+	return len(s.overlapping(r)) > 0
+}
+
+// RangeContaining returns the range of the set that holds the key, and whether there is one
+func (s *RangeSet[K]) RangeContaining(key K) (Interval[K], bool) {
+	if s.ranges == nil {
+		return Interval[K]{}, false
+	}
+	node := s.ranges.floor(key, true)
+	if node == nil || node.value <= key {
+		return Interval[K]{}, false
+	}
+	return Interval[K]{Start: node.key, End: node.value}, true
+}
+
+// Complement returns the keys within the given bounds that are not in the set
+func (s *RangeSet[K]) Complement(within Interval[K]) *RangeSet[K] {
+	complement := NewRangeSet[K]()
+	if within.IsEmpty() {
+		return complement
+	}
+	cursor := within.Start
+	for _, r := range s.overlapping(within) {
+		if cursor < r.Start {
+			complement.ranges.put(cursor, r.Start)
+		}
+		cursor = max(cursor, r.End)
+	}
+	if cursor < within.End {
+		complement.ranges.put(cursor, within.End)
+	}
+	return complement
+}
+
+// Span returns the smallest range enclosing the whole set, or false if the set is empty
+func (s *RangeSet[K]) Span() (Interval[K], bool) {
+	if s.IsEmpty() {
+		return Interval[K]{}, false
+	}
+	first, last := s.ranges.root.min(), s.ranges.root.max()
+	return Interval[K]{Start: first.key, End: last.value}, true
+}
+
+// Union returns the keys in either set
+func (s *RangeSet[K]) Union(other *RangeSet[K]) *RangeSet[K] {
+	union := s.Copy()
+	for r := range other.Iter() {
+		union.Add(r)
+	}
+	return union
+}
+
+// Intersect returns the keys in both sets
+func (s *RangeSet[K]) Intersect(other *RangeSet[K]) *RangeSet[K] {
+	intersection := NewRangeSet[K]()
+	for r := range other.Iter() {
+		for _, overlap := range s.overlapping(r) {
+			intersection.ranges.put(max(overlap.Start, r.Start), min(overlap.End, r.End))
+		}
+	}
+	return intersection
+}
+
+// Len returns the number of disjoint ranges
+func (s *RangeSet[K]) Len() int {
+	if s.ranges == nil {
+		return 0
+	}
+	return s.ranges.len()
+}
+
+func (s *RangeSet[K]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *RangeSet[K]) Clear() {
+	s.ranges = nil
+}
+
+func (s *RangeSet[K]) Copy() *RangeSet[K] {
+	copied := NewRangeSet[K]()
+	for r := range s.Iter() {
+		copied.ranges.put(r.Start, r.End)
+	}
+	return copied
+}
+
+// Iter iterates the disjoint ranges in increasing order
+func (s *RangeSet[K]) Iter() iter.Seq[Interval[K]] {
+	return func(yield func(Interval[K]) bool) {
+		if s.ranges == nil {
+			return
+		}
+		s.ranges.ascend(treeRange[K]{}, s.ranges.root, func(node *treeNode[K, K]) bool {
+			return yield(Interval[K]{Start: node.key, End: node.value})
+		})
+	}
+}
+
+func (s *RangeSet[K]) Ranges() List[Interval[K]] {
+	ranges := make(List[Interval[K]], 0, s.Len())
+	for r := range s.Iter() {
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+func (s *RangeSet[K]) String() string {
+	var builder strings.Builder
+	builder.WriteString("{")
+	for r := range s.Iter() {
+		if builder.Len() > 1 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(r.String())
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+func (s *RangeSet[K]) init() {
+	if s.ranges == nil {
+		s.ranges = newTree[K, K](cmp.Compare[K])
+	}
+}
+
+// overlapping returns the ranges of the set sharing keys with r
+func (s *RangeSet[K]) overlapping(r Interval[K]) []Interval[K] {
+	return s.collect(r, false)
+}
+
+// touching returns the ranges of the set sharing keys with r or adjacent to it
+func (s *RangeSet[K]) touching(r Interval[K]) []Interval[K] {
+	return s.collect(r, true)
+}
+
+func (s *RangeSet[K]) collect(r Interval[K], adjacent bool) []Interval[K] {
+	if r.IsEmpty() || s.ranges == nil {
+		return nil
+	}
+	var found []Interval[K]
+	if before := s.ranges.floor(r.Start, false); before != nil && (before.value > r.Start || adjacent && before.value == r.Start) {
+		found = append(found, Interval[K]{Start: before.key, End: before.value})
+	}
+	within := treeRange[K]{
+		lo: treeBound[K]{key: r.Start, inclusive: true, set: true},
+		hi: treeBound[K]{key: r.End, inclusive: adjacent, set: true},
+	}
+	s.ranges.ascend(within, s.ranges.root, func(node *treeNode[K, K]) bool {
+		found = append(found, Interval[K]{Start: node.key, End: node.value})
+		return true
+	})
+	return found
+}
@@ -0,0 +1,102 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestRangeSetAdd(t *testing.T) {
+	t.Run("coalesces overlapping and adjacent ranges", func(t *testing.T) {
+		s := collection.RangeSetOf(collection.IntervalOf(10, 20), collection.IntervalOf(30, 40))
+		s.Add(collection.IntervalOf(20, 25))
+		assert.Equal(t, "{[10, 25), [30, 40)}", s.String())
+
+		s.Add(collection.IntervalOf(24, 31))
+		assert.Equal(t, "{[10, 40)}", s.String())
+
+		s.Add(collection.IntervalOf(12, 18))
+		s.Add(collection.IntervalOf(5, 5))
+		s.Add(collection.IntervalOf(50, 60))
+		assert.Equal(t, collection.List[collection.Interval[int]]{
+			collection.IntervalOf(10, 40),
+			collection.IntervalOf(50, 60),
+		}, s.Ranges())
+		assert.Equal(t, 2, s.Len())
+	})
+
+	t.Run("swallows ranges it covers", func(t *testing.T) {
+		s := collection.RangeSetOf(collection.IntervalOf(1, 2), collection.IntervalOf(3, 4), collection.IntervalOf(5, 6))
+		s.Add(collection.IntervalOf(0, 10))
+		assert.Equal(t, "{[0, 10)}", s.String())
+	})
+}
+
+func TestRangeSetRemove(t *testing.T) {
+	var s collection.RangeSet[int]
+	s.Remove(collection.IntervalOf(0, 10))
+	s.Add(collection.IntervalOf(0, 100))
+
+	s.Remove(collection.IntervalOf(40, 60))
+	assert.Equal(t, "{[0, 40), [60, 100)}", s.String())
+
+	s.Remove(collection.IntervalOf(30, 70))
+	assert.Equal(t, "{[0, 30), [70, 100)}", s.String())
+
+	s.Remove(collection.IntervalOf(0, 30))
+	s.Remove(collection.IntervalOf(100, 200))
+	assert.Equal(t, "{[70, 100)}", s.String())
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func TestRangeSetQueries(t *testing.T) {
+	allowed := collection.RangeSetOf(collection.IntervalOf(10, 20), collection.IntervalOf(30, 40))
+
+	t.Run("contains keys and ranges", func(t *testing.T) {
+		assert.True(t, allowed.Contains(10))
+		assert.True(t, allowed.Contains(19))
+		assert.False(t, allowed.Contains(20))
+		assert.False(t, allowed.Contains(5))
+
+		assert.True(t, allowed.Encloses(collection.IntervalOf(12, 20)))
+		assert.False(t, allowed.Encloses(collection.IntervalOf(15, 35)))
+		assert.True(t, allowed.Overlaps(collection.IntervalOf(15, 35)))
+		assert.False(t, allowed.Overlaps(collection.IntervalOf(20, 30)))
+
+		r, ok := allowed.RangeContaining(35)
+		assert.True(t, ok)
+		assert.Equal(t, collection.IntervalOf(30, 40), r)
+	})
+
+	t.Run("complement within bounds", func(t *testing.T) {
+		assert.Equal(t, "{[0, 10), [20, 30), [40, 50)}", allowed.Complement(collection.IntervalOf(0, 50)).String())
+		assert.Equal(t, "{[20, 30)}", allowed.Complement(collection.IntervalOf(15, 35)).String())
+		assert.True(t, allowed.Complement(collection.IntervalOf(12, 18)).IsEmpty())
+	})
+
+	t.Run("span", func(t *testing.T) {
+		span, ok := allowed.Span()
+		assert.True(t, ok)
+		assert.Equal(t, collection.IntervalOf(10, 40), span)
+
+		_, ok = collection.NewRangeSet[int]().Span()
+		assert.False(t, ok)
+	})
+
+	t.Run("union and intersection", func(t *testing.T) {
+		other := collection.RangeSetOf(collection.IntervalOf(15, 32), collection.IntervalOf(38, 45))
+		assert.Equal(t, "{[10, 45)}", allowed.Union(other).String())
+		assert.Equal(t, "{[15, 20), [30, 32), [38, 40)}", allowed.Intersect(other).String())
+		assert.Equal(t, "{[10, 20), [30, 40)}", allowed.String())
+	})
+
+	t.Run("works with strings", func(t *testing.T) {
+		names := collection.RangeSetOf(collection.IntervalOf("a", "c"), collection.IntervalOf("c", "f"))
+		assert.Equal(t, "{[a, f)}", names.String())
+		assert.True(t, names.Contains("cat"))
+		assert.False(t, names.Contains("f"))
+	})
+}
@@ -0,0 +1,233 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"sync"
+
+	"github.com/marlonbarreto-git/gollections/sequence"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+var BufferFullError = errors.New("buffer is full")
+
+// OverflowPolicy decides what a full RingBuffer does with a new item
+type OverflowPolicy int
+
+const (
+	// Overwrite drops the oldest item to make room for the new one
+	Overwrite OverflowPolicy = iota
+	// Reject refuses the new item
+	Reject
+	// Block waits until a Pop or Clear makes room
+	Block
+)
+
+// RingBuffer holds at most a fixed number of items in insertion order, reusing the same storage forever.
+// It is safe for concurrent use, so with the Block policy it works as a bounded queue between goroutines.
+// Iteration and snapshots copy the window of items present at that moment.
+type RingBuffer[T any] struct {
+	mu      sync.Mutex
+	notFull *sync.Cond
+	buffer  []T
+	head    int
+	size    int
+	policy  OverflowPolicy
+	dropped uint64
+}
+
+// NewRingBuffer creates an empty RingBuffer holding up to capacity items. It panics if capacity is not positive.
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("non-positive capacity: %d", capacity))
+	}
+	b := &RingBuffer[T]{buffer: make([]T, capacity), policy: policy}
+	b.notFull = sync.NewCond(&b.mu)
+	return b
+}
+
+// Push adds the item as the newest one. When the buffer is full, it overwrites the oldest item, returns false
+// without adding, or waits for room, depending on the policy.
+func (b *RingBuffer[T]) Push(item T) bool {
+	return b.PushContext(context.Background(), item) == nil
+}
+
+// PushContext is Push with a context that bounds the wait of the Block policy. It returns BufferFullError when
+// the Reject policy refuses the item, or the context's error if it ends before there is room.
+func (b *RingBuffer[T]) PushContext(ctx context.Context, item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.size == len(b.buffer) {
+		switch b.policy {
+		case Overwrite:
+			b.popLocked()
+			b.dropped++
+		case Reject:
+			return BufferFullError
+		case Block:
+			if err := b.waitForRoom(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	b.buffer[(b.head+b.size)%len(b.buffer)] = item
+	b.size++
+	return nil
+}
+
+// PushAll pushes the items in order, returning how many were added
+func (b *RingBuffer[T]) PushAll(items ...T) int {
+	added := 0
+	for _, item := range items {
+		if b.Push(item) {
+			added++
+		}
+	}
+	return added
+}
+
+// Pop removes and returns the oldest item
+func (b *RingBuffer[T]) Pop() optional.Optional[T] {
+	if item, ok := b.TryPop(); ok {
+		return optional.Of(item)
+	}
+	return optional.Empty[T]()
+}
+
+// TryPop removes and returns the oldest item, and whether there was one
+func (b *RingBuffer[T]) TryPop() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.size == 0 {
+		var zero T
+		return zero, false
+	}
+	item := b.popLocked()
+	// a single waiter might be one whose context just ended, so wake them all
+	b.notFull.Broadcast()
+	return item, true
+}
+
+// First returns the oldest item
+func (b *RingBuffer[T]) First() optional.Optional[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.size == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(b.buffer[b.head])
+}
+
+// Last returns the newest item
+func (b *RingBuffer[T]) Last() optional.Optional[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.size == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(b.buffer[(b.head+b.size-1)%len(b.buffer)])
+}
+
+func (b *RingBuffer[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size
+}
+
+func (b *RingBuffer[T]) Cap() int {
+	return len(b.buffer)
+}
+
+func (b *RingBuffer[T]) IsEmpty() bool {
+	return b.Len() == 0
+}
+
+func (b *RingBuffer[T]) IsFull() bool {
+	return b.Len() == len(b.buffer)
+}
+
+// Dropped returns how many items the Overwrite policy has discarded so far
+func (b *RingBuffer[T]) Dropped() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dropped
+}
+
+func (b *RingBuffer[T]) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	clear(b.buffer)
+	b.head, b.size = 0, 0
+	b.notFull.Broadcast()
+}
+
+// Snapshot returns the items from oldest to newest
+func (b *RingBuffer[T]) Snapshot() List[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	items := make(List[T], b.size)
+	for i := range b.size {
+		items[i] = b.buffer[(b.head+i)%len(b.buffer)]
+	}
+	return items
+}
+
+// Iter iterates a snapshot of the items from oldest to newest
+func (b *RingBuffer[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range b.Snapshot() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// AsSequence returns a sequence over a snapshot of the items from oldest to newest, taken when it is iterated
+func (b *RingBuffer[T]) AsSequence() sequence.Seq[T] {
+	return sequence.FromIter(b.Iter())
+}
+
+func (b *RingBuffer[T]) String() string {
+	var str strings.Builder
+	str.WriteString("[")
+	for i, item := range b.Snapshot() {
+		if i > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v", item))
+	}
+	str.WriteString("]")
+	return str.String()
+}
+
+func (b *RingBuffer[T]) popLocked() T {
+	var zero T
+	item := b.buffer[b.head]
+	b.buffer[b.head] = zero
+	b.head = (b.head + 1) % len(b.buffer)
+	b.size--
+	return item
+}
+
+// waitForRoom waits, with the lock held, until the buffer has room or the context ends
+func (b *RingBuffer[T]) waitForRoom(ctx context.Context) error {
+	stop := context.AfterFunc(ctx, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.notFull.Broadcast()
+	})
+	defer stop()
+
+	for b.size == len(b.buffer) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		b.notFull.Wait()
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	events := collection.NewRingBuffer[string](3, collection.Overwrite)
+	assert.Equal(t, 3, events.PushAll("a", "b", "c"))
+	assert.True(t, events.IsFull())
+
+	assert.True(t, events.Push("d"))
+	assert.True(t, events.Push("e"))
+	assert.Equal(t, collection.List[string]{"c", "d", "e"}, events.Snapshot())
+	assert.Equal(t, uint64(2), events.Dropped())
+	assert.Equal(t, "c", events.First().GetValue())
+	assert.Equal(t, "e", events.Last().GetValue())
+	assert.Equal(t, "[c, d, e]", events.String())
+	assert.Equal(t, 3, events.Len())
+	assert.Equal(t, 3, events.Cap())
+}
+
+func TestRingBufferReject(t *testing.T) {
+	b := collection.NewRingBuffer[int](2, collection.Reject)
+	assert.Equal(t, 2, b.PushAll(1, 2, 3))
+	assert.False(t, b.Push(4))
+	assert.ErrorIs(t, b.PushContext(context.Background(), 4), collection.BufferFullError)
+	assert.Equal(t, collection.List[int]{1, 2}, b.Snapshot())
+	assert.Equal(t, uint64(0), b.Dropped())
+
+	assert.Equal(t, 1, b.Pop().GetValue())
+	assert.True(t, b.Push(3))
+	assert.Equal(t, collection.List[int]{2, 3}, b.Snapshot())
+}
+
+func TestRingBufferBlock(t *testing.T) {
+	t.Run("waits for room", func(t *testing.T) {
+		b := collection.NewRingBuffer[int](2, collection.Block)
+		b.PushAll(1, 2)
+
+		pushed := make(chan struct{})
+		go func() {
+			b.Push(3)
+			close(pushed)
+		}()
+
+		select {
+		case <-pushed:
+			t.Fatal("push did not wait for room")
+		case <-time.After(20 * time.Millisecond):
+		}
+		item, ok := b.TryPop()
+		assert.True(t, ok)
+		assert.Equal(t, 1, item)
+		<-pushed
+		assert.Equal(t, collection.List[int]{2, 3}, b.Snapshot())
+	})
+
+	t.Run("stops waiting when the context ends", func(t *testing.T) {
+		b := collection.NewRingBuffer[int](1, collection.Block)
+		b.Push(1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := b.PushContext(ctx, 2)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, collection.List[int]{1}, b.Snapshot())
+	})
+
+	t.Run("works as a bounded queue", func(t *testing.T) {
+		b := collection.NewRingBuffer[int](4, collection.Block)
+		var producers sync.WaitGroup
+		for p := 0; p < 4; p++ {
+			producers.Add(1)
+			go func() {
+				defer producers.Done()
+				for i := 0; i < 250; i++ {
+					b.Push(i)
+				}
+			}()
+		}
+
+		sum, received := 0, 0
+		for received < 1000 {
+			if item, ok := b.TryPop(); ok {
+				sum += item
+				received++
+				assert.LessOrEqual(t, b.Len(), 4)
+			} else {
+				runtime.Gosched()
+			}
+		}
+		producers.Wait()
+		assert.Equal(t, 4*249*250/2, sum)
+		assert.True(t, b.IsEmpty())
+	})
+}
+
+func TestRingBufferIteration(t *testing.T) {
+	b := collection.NewRingBuffer[int](4, collection.Overwrite)
+	b.PushAll(1, 2, 3, 4, 5, 6)
+
+	var items []int
+	for item := range b.Iter() {
+		b.Push(item * 10)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{3, 4, 5, 6}, items)
+	assert.Equal(t, []int{40, 50, 60}, b.AsSequence().Filter(func(item int) bool { return item > 30 }).ToSlice())
+
+	b.Clear()
+	assert.True(t, b.IsEmpty())
+	assert.True(t, b.Pop().IsEmpty())
+	assert.True(t, b.First().IsEmpty())
+	assert.True(t, b.Last().IsEmpty())
+	_, ok := b.TryPop()
+	assert.False(t, ok)
+	assert.Panics(t, func() { collection.NewRingBuffer[int](0, collection.Reject) })
+}
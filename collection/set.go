@@ -8,6 +8,8 @@ import (
 	. "github.com/marlonbarreto-git/gollections/tomove/types"
 )
 
+// Set is a plain Go map of keys. Like any map it is not safe for concurrent use
+// when at least one goroutine writes; use ConcurrentSet for that.
 type Set[K comparable] map[K]Empty
 
 func (s Set[K]) Contains(item K) bool {
@@ -0,0 +1,197 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// SetMultimap maps each key to a set of values, so a key holds each value at most once.
+// Len counts every key-value entry. The zero value is an empty multimap.
+type SetMultimap[K, V comparable] struct {
+	buckets map[K]Set[V]
+	size    int
+}
+
+func NewSetMultimap[K, V comparable]() *SetMultimap[K, V] {
+	return &SetMultimap[K, V]{buckets: map[K]Set[V]{}}
+}
+
+func SetMultimapOf[K, V comparable](pairs ...Pair[K, V]) *SetMultimap[K, V] {
+	m := NewSetMultimap[K, V]()
+	for _, pair := range pairs {
+		m.Put(pair.First(), pair.Second())
+	}
+	return m
+}
+
+// Put adds the value under the key and reports whether it was not already there
+func (m *SetMultimap[K, V]) Put(key K, value V) bool {
+	if m.buckets == nil {
+		m.buckets = map[K]Set[V]{}
+	}
+	values, ok := m.buckets[key]
+	if !ok {
+		values = Set[V]{}
+		m.buckets[key] = values
+	}
+	if !values.Add(value) {
+		return false
+	}
+	m.size++
+	return true
+}
+
+// PutAll adds the values under the key and returns how many were not already there
+func (m *SetMultimap[K, V]) PutAll(key K, values ...V) (added int) {
+	for _, value := range values {
+		if m.Put(key, value) {
+			added++
+		}
+	}
+	return
+}
+
+// Get returns a copy of the values for the key, empty if there are none
+func (m *SetMultimap[K, V]) Get(key K) Set[V] {
+	result := Set[V]{}
+	for value := range m.buckets[key] {
+		result.Add(value)
+	}
+	return result
+}
+
+func (m *SetMultimap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.buckets[key]
+	return ok
+}
+
+func (m *SetMultimap[K, V]) ContainsEntry(key K, value V) bool {
+	return m.buckets[key].Contains(value)
+}
+
+// RemoveValue removes the value from the key and reports whether it was there
+func (m *SetMultimap[K, V]) RemoveValue(key K, value V) bool {
+	values := m.buckets[key]
+	if !values.Contains(value) {
+		return false
+	}
+
+	values.Remove(value)
+	if values.IsEmpty() {
+		delete(m.buckets, key)
+	}
+	m.size--
+	return true
+}
+
+// RemoveAll removes the key and returns the values it had
+func (m *SetMultimap[K, V]) RemoveAll(key K) Set[V] {
+	values := m.buckets[key]
+	delete(m.buckets, key)
+	m.size -= len(values)
+	return values
+}
+
+func (m *SetMultimap[K, V]) Clear() {
+	clear(m.buckets)
+	m.size = 0
+}
+
+// Len returns the number of key-value entries
+func (m *SetMultimap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *SetMultimap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m *SetMultimap[K, V]) Keys() Set[K] {
+	keys := make(Set[K], len(m.buckets))
+	for key := range m.buckets {
+		keys.Add(key)
+	}
+	return keys
+}
+
+// KeysWithCount returns the keys in a Bag, each counted once per value it holds
+func (m *SetMultimap[K, V]) KeysWithCount() *Bag[K] {
+	keys := NewBag[K]()
+	for key, values := range m.buckets {
+		keys.Add(key, len(values))
+	}
+	return keys
+}
+
+func (m *SetMultimap[K, V]) Values() List[V] {
+	values := make(List[V], 0, m.size)
+	for _, v := range m.Iter() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Entries flattens the multimap into one pair per key-value entry, in no particular order
+func (m *SetMultimap[K, V]) Entries() []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, m.size)
+	for k, v := range m.Iter() {
+		entries = append(entries, PairOf(k, v))
+	}
+	return entries
+}
+
+// Iter returns an iterator over every key-value entry. Keys come in no particular order.
+func (m *SetMultimap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range m.buckets {
+			for value := range values {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *SetMultimap[K, V]) ForEach(fn func(K, V)) {
+	for k, v := range m.Iter() {
+		fn(k, v)
+	}
+}
+
+// AsMap returns a copy of the grouping as a map from each key to its values
+func (m *SetMultimap[K, V]) AsMap() MutableMap[K, Set[V]] {
+	result := make(MutableMap[K, Set[V]], len(m.buckets))
+	for key := range m.buckets {
+		result[key] = m.Get(key)
+	}
+	return result
+}
+
+// InvertSetMultimap returns a copy of the multimap mapping each value to the keys it is stored under.
+// Later changes to either multimap do not show in the other. It is a function to match InvertListMultimap.
+func InvertSetMultimap[K, V comparable](m *SetMultimap[K, V]) *SetMultimap[V, K] {
+	inverse := NewSetMultimap[V, K]()
+	for k, v := range m.Iter() {
+		inverse.Put(v, k)
+	}
+	return inverse
+}
+
+func (m *SetMultimap[K, V]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for key, values := range m.buckets {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v: %v", key, values))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
@@ -0,0 +1,66 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestSetMultimapPut(t *testing.T) {
+	var m collection.SetMultimap[string, int]
+
+	assert.True(t, m.Put("a", 1))
+	assert.False(t, m.Put("a", 1))
+	assert.Equal(t, 2, m.PutAll("a", 1, 2, 3))
+	assert.Equal(t, 3, m.Len())
+	assert.MapEqual(t, collection.Set[int]{1: {}, 2: {}, 3: {}}, m.Get("a"))
+	assert.Equal(t, 0, m.Get("z").Len())
+
+	values := m.Get("a")
+	values.Add(9)
+	assert.False(t, m.ContainsEntry("a", 9))
+}
+
+func TestSetMultimapRemove(t *testing.T) {
+	m := collection.SetMultimapOf(collection.PairOf("a", 1), collection.PairOf("a", 2), collection.PairOf("b", 3))
+
+	assert.True(t, m.RemoveValue("b", 3))
+	assert.False(t, m.RemoveValue("b", 3))
+	assert.False(t, m.ContainsKey("b"))
+	assert.MapEqual(t, collection.Set[int]{1: {}, 2: {}}, m.RemoveAll("a"))
+	assert.True(t, m.IsEmpty())
+
+	m.Put("c", 1)
+	m.Clear()
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSetMultimapViews(t *testing.T) {
+	m := collection.SetMultimapOf(
+		collection.PairOf("alice", "admin"), collection.PairOf("alice", "dev"), collection.PairOf("bob", "dev"),
+	)
+
+	assert.True(t, m.ContainsEntry("bob", "dev"))
+	assert.MapEqual(t, collection.Set[string]{"alice": {}, "bob": {}}, m.Keys())
+	assert.MapEqual(t, map[string]int{"alice": 2, "bob": 1}, m.KeysWithCount().ToMap())
+	assert.Equal(t, 3, len(m.Values()))
+	assert.Contains(t, m.Entries(), collection.PairOf("bob", "dev"))
+	assert.Equal(t, 2, m.AsMap()["alice"].Len())
+	assert.Equal(t, "{bob: {dev}}", collection.SetMultimapOf(collection.PairOf("bob", "dev")).String())
+
+	count := 0
+	m.ForEach(func(string, string) { count++ })
+	assert.Equal(t, 3, count)
+
+	inverse := collection.InvertSetMultimap(m)
+	assert.MapEqual(t, collection.Set[string]{"alice": {}, "bob": {}}, inverse.Get("dev"))
+	assert.MapEqual(t, collection.Set[string]{"alice": {}}, inverse.Get("admin"))
+	assert.Equal(t, 3, inverse.Len())
+
+	// the inverse is a copy
+	m.Put("carol", "ops")
+	inverse.Put("qa", "dave")
+	assert.False(t, inverse.ContainsKey("ops"))
+	assert.False(t, m.ContainsKey("dave"))
+}
@@ -0,0 +1,260 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	. "github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+// SortedMap is a map that keeps its keys ordered, backed by a balanced binary search tree.
+// HeadMap, TailMap and SubMap return views that share storage with the map they come from.
+// The zero value is not usable, since it has no order: create maps with NewSortedMap or NewSortedMapFunc.
+type SortedMap[K comparable, V any] struct {
+	tree   *tree[K, V]
+	bounds treeRange[K]
+}
+
+// NewSortedMap creates an empty SortedMap ordered by the natural order of its keys
+func NewSortedMap[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return NewSortedMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewSortedMapFunc creates an empty SortedMap ordered by the given comparator
+func NewSortedMapFunc[K comparable, V any](comparator Comparator[K]) *SortedMap[K, V] {
+	return &SortedMap[K, V]{tree: newTree[K, V](comparator)}
+}
+
+// Put associates the value with the key. It panics if the key falls outside the range of a view.
+func (m *SortedMap[K, V]) Put(key K, value V) {
+	m.checkInRange(key)
+	m.tree.put(key, value)
+}
+
+func (m *SortedMap[K, V]) PutAll(pairs ...Pair[K, V]) {
+	for _, pair := range pairs {
+		m.Put(pair.First(), pair.Second())
+	}
+}
+
+func (m *SortedMap[K, V]) Get(key K) optional.Optional[V] {
+	if node := m.node(key); node != nil {
+		return optional.Of(node.value)
+	}
+	return optional.Empty[V]()
+}
+
+func (m *SortedMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if node := m.node(key); node != nil {
+		return node.value
+	}
+	return defaultValue
+}
+
+func (m *SortedMap[K, V]) GetOrPut(key K, defaultFn func() V) V {
+	if node := m.node(key); node != nil {
+		return node.value
+	}
+	value := defaultFn()
+	m.Put(key, value)
+	return value
+}
+
+func (m *SortedMap[K, V]) ContainsKey(key K) bool {
+	return m.node(key) != nil
+}
+
+func (m *SortedMap[K, V]) Remove(key K) {
+	if m.tree.inRange(m.bounds, key) {
+		m.tree.remove(key)
+	}
+}
+
+func (m *SortedMap[K, V]) Clear() {
+	for _, key := range m.Keys() {
+		m.tree.remove(key)
+	}
+}
+
+func (m *SortedMap[K, V]) Len() int {
+	return m.tree.lenIn(m.bounds)
+}
+
+func (m *SortedMap[K, V]) IsEmpty() bool {
+	return m.tree.first(m.bounds) == nil
+}
+
+func (m *SortedMap[K, V]) FirstEntry() optional.Optional[Pair[K, V]] {
+	return entryOf(m.tree.first(m.bounds))
+}
+
+func (m *SortedMap[K, V]) LastEntry() optional.Optional[Pair[K, V]] {
+	return entryOf(m.tree.last(m.bounds))
+}
+
+// FloorEntry returns the entry with the greatest key less than or equal to the given key
+func (m *SortedMap[K, V]) FloorEntry(key K) optional.Optional[Pair[K, V]] {
+	return entryOf(m.tree.floorIn(m.bounds, key, true))
+}
+
+// CeilingEntry returns the entry with the least key greater than or equal to the given key
+func (m *SortedMap[K, V]) CeilingEntry(key K) optional.Optional[Pair[K, V]] {
+	return entryOf(m.tree.ceilingIn(m.bounds, key, true))
+}
+
+// LowerEntry returns the entry with the greatest key strictly less than the given key
+func (m *SortedMap[K, V]) LowerEntry(key K) optional.Optional[Pair[K, V]] {
+	return entryOf(m.tree.floorIn(m.bounds, key, false))
+}
+
+// HigherEntry returns the entry with the least key strictly greater than the given key
+func (m *SortedMap[K, V]) HigherEntry(key K) optional.Optional[Pair[K, V]] {
+	return entryOf(m.tree.ceilingIn(m.bounds, key, false))
+}
+
+// HeadMap returns a view of the entries whose keys are strictly less than toKey
+func (m *SortedMap[K, V]) HeadMap(toKey K) *SortedMap[K, V] {
+	return m.view(treeBound[K]{}, treeBound[K]{key: toKey, set: true})
+}
+
+// TailMap returns a view of the entries whose keys are greater than or equal to fromKey
+func (m *SortedMap[K, V]) TailMap(fromKey K) *SortedMap[K, V] {
+	return m.view(treeBound[K]{key: fromKey, inclusive: true, set: true}, treeBound[K]{})
+}
+
+// SubMap returns a view of the entries whose keys range from fromKey, inclusive, to toKey, exclusive.
+// It panics if fromKey is greater than toKey.
+func (m *SortedMap[K, V]) SubMap(fromKey, toKey K) *SortedMap[K, V] {
+	if m.tree.compare(fromKey, toKey) > 0 {
+		panic("fromKey is greater than toKey")
+	}
+	return m.view(treeBound[K]{key: fromKey, inclusive: true, set: true}, treeBound[K]{key: toKey, set: true})
+}
+
+// Iter returns an iterator over the entries in ascending key order
+func (m *SortedMap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.tree.ascend(m.bounds, m.tree.root, func(node *treeNode[K, V]) bool {
+			return yield(node.key, node.value)
+		})
+	}
+}
+
+// Backward returns an iterator over the entries in descending key order
+func (m *SortedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.tree.descend(m.bounds, m.tree.root, func(node *treeNode[K, V]) bool {
+			return yield(node.key, node.value)
+		})
+	}
+}
+
+func (m *SortedMap[K, V]) Keys() List[K] {
+	keys := make(List[K], 0, m.Len())
+	for key := range m.Iter() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *SortedMap[K, V]) Values() List[V] {
+	values := make(List[V], 0, m.Len())
+	for _, value := range m.Iter() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (m *SortedMap[K, V]) Entries() []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, m.Len())
+	for key, value := range m.Iter() {
+		entries = append(entries, PairOf(key, value))
+	}
+	return entries
+}
+
+func (m *SortedMap[K, V]) ForEach(consumer BiConsumer[K, V]) {
+	for key, value := range m.Iter() {
+		consumer(key, value)
+	}
+}
+
+// Filter returns a new SortedMap, with the same ordering, holding the entries that pass the predicate
+func (m *SortedMap[K, V]) Filter(predicate BiPredicate[K, V]) *SortedMap[K, V] {
+	result := NewSortedMapFunc[K, V](m.tree.compare)
+	for key, value := range m.Iter() {
+		if predicate(key, value) {
+			result.tree.put(key, value)
+		}
+	}
+	return result
+}
+
+func (m *SortedMap[K, V]) Any(predicate BiPredicate[K, V]) bool {
+	for key, value := range m.Iter() {
+		if predicate(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *SortedMap[K, V]) All(predicate BiPredicate[K, V]) bool {
+	return !m.Any(func(key K, value V) bool { return !predicate(key, value) })
+}
+
+func (m *SortedMap[K, V]) None(predicate BiPredicate[K, V]) bool {
+	return !m.Any(predicate)
+}
+
+func (m *SortedMap[K, V]) ToMap() MutableMap[K, V] {
+	result := make(MutableMap[K, V], m.Len())
+	for key, value := range m.Iter() {
+		result[key] = value
+	}
+	return result
+}
+
+func (m *SortedMap[K, V]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for key, value := range m.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v: %v", key, value))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
+
+func (m *SortedMap[K, V]) node(key K) *treeNode[K, V] {
+	if !m.tree.inRange(m.bounds, key) {
+		return nil
+	}
+	return m.tree.get(key)
+}
+
+func (m *SortedMap[K, V]) view(lo, hi treeBound[K]) *SortedMap[K, V] {
+	return &SortedMap[K, V]{tree: m.tree, bounds: m.tree.narrow(m.bounds, lo, hi)}
+}
+
+func (m *SortedMap[K, V]) checkInRange(key K) {
+	if !m.tree.inRange(m.bounds, key) {
+		panic(fmt.Sprintf("key %v is out of the view range", key))
+	}
+}
+
+func entryOf[K comparable, V any](node *treeNode[K, V]) optional.Optional[Pair[K, V]] {
+	if node == nil {
+		return optional.Empty[Pair[K, V]]()
+	}
+	return optional.Of(PairOf(node.key, node.value))
+}
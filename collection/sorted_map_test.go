@@ -0,0 +1,279 @@
+package collection_test
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func sortedMapOf(keys ...int) *collection.SortedMap[int, string] {
+	m := collection.NewSortedMap[int, string]()
+	for _, key := range keys {
+		m.Put(key, strings.Repeat("v", key))
+	}
+	return m
+}
+
+func keyOf(entry collection.Pair[int, string]) int {
+	return entry.First()
+}
+
+func TestSortedMapPutGet(t *testing.T) {
+	t.Run("keeps keys ordered", func(t *testing.T) {
+		m := sortedMapOf(5, 1, 4, 2, 3)
+
+		assert.Equal(t, collection.List[int]{1, 2, 3, 4, 5}, m.Keys())
+		assert.Equal(t, 5, m.Len())
+	})
+
+	t.Run("replaces existing values", func(t *testing.T) {
+		m := collection.NewSortedMap[string, int]()
+		m.Put("a", 1)
+		m.Put("a", 2)
+
+		assert.Equal(t, 1, m.Len())
+		assert.Equal(t, 2, m.Get("a").GetValue())
+	})
+
+	t.Run("gets empty optional for missing keys", func(t *testing.T) {
+		m := sortedMapOf(1)
+
+		assert.True(t, m.Get(2).IsEmpty())
+		assert.Equal(t, "x", m.GetOrDefault(2, "x"))
+		assert.False(t, m.ContainsKey(2))
+	})
+
+	t.Run("puts pairs", func(t *testing.T) {
+		m := collection.NewSortedMap[string, int]()
+		m.PutAll(collection.PairOf("b", 2), collection.PairOf("a", 1))
+
+		assert.Equal(t, collection.List[string]{"a", "b"}, m.Keys())
+		assert.Equal(t, collection.List[int]{1, 2}, m.Values())
+	})
+}
+
+func TestSortedMapGetOrPut(t *testing.T) {
+	m := collection.NewSortedMap[string, int]()
+	calls := 0
+	supplier := func() int {
+		calls++
+		return 7
+	}
+
+	assert.Equal(t, 7, m.GetOrPut("a", supplier))
+	assert.Equal(t, 7, m.GetOrPut("a", supplier))
+	assert.Equal(t, 1, calls)
+}
+
+func TestSortedMapRemove(t *testing.T) {
+	m := sortedMapOf(1, 2, 3, 4, 5)
+	m.Remove(3)
+	m.Remove(42)
+
+	assert.Equal(t, collection.List[int]{1, 2, 4, 5}, m.Keys())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSortedMapComparator(t *testing.T) {
+	m := collection.NewSortedMapFunc[string, int](func(a, b string) int {
+		return len(a) - len(b)
+	})
+	m.Put("ccc", 3)
+	m.Put("a", 1)
+	m.Put("bb", 2)
+
+	assert.Equal(t, collection.List[string]{"a", "bb", "ccc"}, m.Keys())
+}
+
+func TestSortedMapNavigation(t *testing.T) {
+	m := sortedMapOf(10, 20, 30)
+
+	t.Run("first and last entries", func(t *testing.T) {
+		assert.Equal(t, 10, keyOf(m.FirstEntry().GetValue()))
+		assert.Equal(t, 30, keyOf(m.LastEntry().GetValue()))
+		assert.Equal(t, strings.Repeat("v", 30), m.LastEntry().GetValue().Second())
+	})
+
+	t.Run("floor and ceiling include the key", func(t *testing.T) {
+		assert.Equal(t, 20, keyOf(m.FloorEntry(20).GetValue()))
+		assert.Equal(t, 20, keyOf(m.FloorEntry(25).GetValue()))
+		assert.True(t, m.FloorEntry(5).IsEmpty())
+		assert.Equal(t, 20, keyOf(m.CeilingEntry(20).GetValue()))
+		assert.Equal(t, 30, keyOf(m.CeilingEntry(25).GetValue()))
+		assert.True(t, m.CeilingEntry(35).IsEmpty())
+	})
+
+	t.Run("lower and higher exclude the key", func(t *testing.T) {
+		assert.Equal(t, 10, keyOf(m.LowerEntry(20).GetValue()))
+		assert.True(t, m.LowerEntry(10).IsEmpty())
+		assert.Equal(t, 30, keyOf(m.HigherEntry(20).GetValue()))
+		assert.True(t, m.HigherEntry(30).IsEmpty())
+	})
+
+	t.Run("empty map has no entries", func(t *testing.T) {
+		empty := collection.NewSortedMap[int, int]()
+
+		assert.True(t, empty.FirstEntry().IsEmpty())
+		assert.True(t, empty.LastEntry().IsEmpty())
+		assert.True(t, empty.FloorEntry(1).IsEmpty())
+	})
+}
+
+func TestSortedMapViews(t *testing.T) {
+	t.Run("head map excludes the bound", func(t *testing.T) {
+		m := sortedMapOf(1, 2, 3, 4, 5)
+		head := m.HeadMap(3)
+
+		assert.Equal(t, collection.List[int]{1, 2}, head.Keys())
+		assert.Equal(t, 2, head.Len())
+		assert.False(t, head.ContainsKey(3))
+	})
+
+	t.Run("tail map includes the bound", func(t *testing.T) {
+		m := sortedMapOf(1, 2, 3, 4, 5)
+		tail := m.TailMap(3)
+
+		assert.Equal(t, collection.List[int]{3, 4, 5}, tail.Keys())
+		assert.Equal(t, 3, keyOf(tail.FirstEntry().GetValue()))
+	})
+
+	t.Run("sub map spans a half open range", func(t *testing.T) {
+		m := sortedMapOf(1, 2, 3, 4, 5)
+		sub := m.SubMap(2, 4)
+
+		assert.Equal(t, collection.List[int]{2, 3}, sub.Keys())
+		assert.Equal(t, 3, keyOf(sub.FloorEntry(10).GetValue()))
+		assert.Equal(t, 2, keyOf(sub.CeilingEntry(0).GetValue()))
+		assert.True(t, sub.HigherEntry(3).IsEmpty())
+		assert.True(t, sub.LowerEntry(2).IsEmpty())
+	})
+
+	t.Run("views share storage with the map", func(t *testing.T) {
+		m := sortedMapOf(1, 5, 9)
+		sub := m.SubMap(2, 8)
+
+		m.Put(6, "six")
+		assert.Equal(t, collection.List[int]{5, 6}, sub.Keys())
+
+		sub.Put(3, "three")
+		sub.Remove(5)
+		assert.Equal(t, collection.List[int]{1, 3, 6, 9}, m.Keys())
+
+		sub.Clear()
+		assert.Equal(t, collection.List[int]{1, 9}, m.Keys())
+	})
+
+	t.Run("nested views intersect their ranges", func(t *testing.T) {
+		m := sortedMapOf(1, 2, 3, 4, 5, 6)
+
+		assert.Equal(t, collection.List[int]{3, 4}, m.TailMap(3).HeadMap(5).Keys())
+		assert.Equal(t, collection.List[int]{2, 3}, m.HeadMap(4).HeadMap(6).TailMap(2).Keys())
+	})
+
+	t.Run("panics when putting outside the view", func(t *testing.T) {
+		head := sortedMapOf(1, 2).HeadMap(3)
+
+		assert.Panics(t, func() { head.Put(3, "three") })
+	})
+
+	t.Run("panics on inverted sub map bounds", func(t *testing.T) {
+		m := sortedMapOf(1)
+
+		assert.Panics(t, func() { m.SubMap(5, 1) })
+	})
+}
+
+func TestSortedMapIteration(t *testing.T) {
+	m := sortedMapOf(3, 1, 2)
+
+	var keys []int
+	for key, value := range m.Iter() {
+		keys = append(keys, key)
+		assert.Equal(t, strings.Repeat("v", key), value)
+	}
+	assert.Equal(t, []int{1, 2, 3}, keys)
+
+	keys = nil
+	for key := range m.Backward() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{3, 2}, keys)
+
+	entries := m.Entries()
+	assert.Equal(t, 1, entries[0].First())
+	assert.Equal(t, 3, entries[2].First())
+}
+
+func TestSortedMapFunctional(t *testing.T) {
+	m := sortedMapOf(1, 2, 3, 4)
+	isEven := func(key int, _ string) bool { return key%2 == 0 }
+
+	assert.Equal(t, collection.List[int]{2, 4}, m.Filter(isEven).Keys())
+	assert.True(t, m.Any(isEven))
+	assert.False(t, m.All(isEven))
+	assert.False(t, m.None(isEven))
+
+	var visited []int
+	m.ForEach(func(key int, _ string) {
+		visited = append(visited, key)
+	})
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+
+	assert.MapEqual(t, map[int]string{1: "v", 2: "vv", 3: "vvv", 4: "vvvv"}, m.ToMap())
+}
+
+func TestSortedMapString(t *testing.T) {
+	m := collection.NewSortedMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+
+	assert.Equal(t, "{a: 1, b: 2}", m.String())
+	assert.Equal(t, "{}", collection.NewSortedMap[int, int]().String())
+}
+
+func TestSortedMapMatchesSortedReference(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	m := collection.NewSortedMap[int, int]()
+	reference := map[int]int{}
+
+	for i := 0; i < 2000; i++ {
+		key := rng.Intn(300)
+		if rng.Intn(3) == 0 {
+			m.Remove(key)
+			delete(reference, key)
+		} else {
+			m.Put(key, i)
+			reference[key] = i
+		}
+	}
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	assert.Equal(t, collection.List[int](keys), m.Keys())
+	assert.Equal(t, len(keys), m.Len())
+	for probe := -1; probe <= 301; probe++ {
+		index, found := slices.BinarySearch(keys, probe)
+		if found {
+			assert.Equal(t, probe, m.FloorEntry(probe).GetValue().First())
+		} else if index > 0 {
+			assert.Equal(t, keys[index-1], m.FloorEntry(probe).GetValue().First())
+		} else {
+			assert.True(t, m.FloorEntry(probe).IsEmpty())
+		}
+		assert.Equal(t, len(keys)-index, m.TailMap(probe).Len())
+	}
+}
@@ -0,0 +1,268 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	. "github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+	. "github.com/marlonbarreto-git/gollections/tomove/types"
+)
+
+// SortedSet is a set that keeps its items ordered, backed by a balanced binary search tree.
+// Unlike Set, iteration, First and String are deterministic. Range returns a view sharing storage with the set.
+// It has every method of Set, taking and returning *SortedSet where Set uses Set.
+// The zero value is not usable: create sets with NewSortedSet, NewSortedSetFunc or SortedSetOf.
+type SortedSet[K comparable] struct {
+	tree   *tree[K, Empty]
+	bounds treeRange[K]
+}
+
+// NewSortedSet creates an empty SortedSet ordered by the natural order of its items
+func NewSortedSet[K cmp.Ordered]() *SortedSet[K] {
+	return NewSortedSetFunc[K](cmp.Compare[K])
+}
+
+// NewSortedSetFunc creates an empty SortedSet ordered by the given comparator
+func NewSortedSetFunc[K comparable](comparator Comparator[K]) *SortedSet[K] {
+	return &SortedSet[K]{tree: newTree[K, Empty](comparator)}
+}
+
+// SortedSetOf creates a SortedSet holding the given items in their natural order
+func SortedSetOf[K cmp.Ordered](items ...K) *SortedSet[K] {
+	s := NewSortedSet[K]()
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+func (s *SortedSet[K]) Contains(item K) bool {
+	return s.tree.inRange(s.bounds, item) && s.tree.get(item) != nil
+}
+
+// Add inserts the item, returning true when it was not already present.
+// It panics if the item falls outside the range of a view.
+func (s *SortedSet[K]) Add(item K) bool {
+	if !s.tree.inRange(s.bounds, item) {
+		panic(fmt.Sprintf("item %v is out of the view range", item))
+	}
+	return s.tree.put(item, EmptyInstance)
+}
+
+func (s *SortedSet[K]) Remove(item K) {
+	if s.tree.inRange(s.bounds, item) {
+		s.tree.remove(item)
+	}
+}
+
+func (s *SortedSet[K]) Clear() {
+	for _, item := range s.Values() {
+		s.tree.remove(item)
+	}
+}
+
+func (s *SortedSet[K]) IsEmpty() bool {
+	return s.tree.first(s.bounds) == nil
+}
+
+func (s *SortedSet[K]) Len() int {
+	return s.tree.lenIn(s.bounds)
+}
+
+func (s *SortedSet[K]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for item := range s.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v", item))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
+
+func (s *SortedSet[K]) Values() List[K] {
+	values := make(List[K], 0, s.Len())
+	for item := range s.Iter() {
+		values = append(values, item)
+	}
+	return values
+}
+
+func (s *SortedSet[K]) ToList() []K {
+	return s.Values()
+}
+
+// Iter returns an iterator over the items in ascending order
+func (s *SortedSet[K]) Iter() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		s.tree.ascend(s.bounds, s.tree.root, func(node *treeNode[K, Empty]) bool {
+			return yield(node.key)
+		})
+	}
+}
+
+// Backward returns an iterator over the items in descending order
+func (s *SortedSet[K]) Backward() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		s.tree.descend(s.bounds, s.tree.root, func(node *treeNode[K, Empty]) bool {
+			return yield(node.key)
+		})
+	}
+}
+
+func (s *SortedSet[K]) Union(other *SortedSet[K]) *SortedSet[K] {
+	result := s.Filter(func(K) bool { return true })
+	for item := range other.Iter() {
+		result.tree.put(item, EmptyInstance)
+	}
+	return result
+}
+
+func (s *SortedSet[K]) Intersect(other *SortedSet[K]) *SortedSet[K] {
+	return s.Filter(other.Contains)
+}
+
+func (s *SortedSet[K]) Subtract(other *SortedSet[K]) *SortedSet[K] {
+	return s.Filter(func(item K) bool { return !other.Contains(item) })
+}
+
+// Filter returns a new SortedSet, with the same ordering, holding the items that pass the predicate
+func (s *SortedSet[K]) Filter(predicate func(K) bool) *SortedSet[K] {
+	result := NewSortedSetFunc[K](s.tree.compare)
+	for item := range s.Iter() {
+		if predicate(item) {
+			result.tree.put(item, EmptyInstance)
+		}
+	}
+	return result
+}
+
+func (s *SortedSet[K]) ForEach(fn func(K)) {
+	for item := range s.Iter() {
+		fn(item)
+	}
+}
+
+func (s *SortedSet[K]) Any(predicate func(K) bool) bool {
+	for item := range s.Iter() {
+		if predicate(item) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SortedSet[K]) All(predicate func(K) bool) bool {
+	return !s.Any(func(item K) bool { return !predicate(item) })
+}
+
+func (s *SortedSet[K]) None(predicate func(K) bool) bool {
+	return !s.Any(predicate)
+}
+
+// First returns the smallest item
+func (s *SortedSet[K]) First() optional.Optional[K] {
+	return keyOf(s.tree.first(s.bounds))
+}
+
+// Last returns the greatest item
+func (s *SortedSet[K]) Last() optional.Optional[K] {
+	return keyOf(s.tree.last(s.bounds))
+}
+
+// Floor returns the greatest item less than or equal to the given one
+func (s *SortedSet[K]) Floor(item K) optional.Optional[K] {
+	return keyOf(s.tree.floorIn(s.bounds, item, true))
+}
+
+// Ceiling returns the least item greater than or equal to the given one
+func (s *SortedSet[K]) Ceiling(item K) optional.Optional[K] {
+	return keyOf(s.tree.ceilingIn(s.bounds, item, true))
+}
+
+// Lower returns the greatest item strictly less than the given one
+func (s *SortedSet[K]) Lower(item K) optional.Optional[K] {
+	return keyOf(s.tree.floorIn(s.bounds, item, false))
+}
+
+// Higher returns the least item strictly greater than the given one
+func (s *SortedSet[K]) Higher(item K) optional.Optional[K] {
+	return keyOf(s.tree.ceilingIn(s.bounds, item, false))
+}
+
+// Range returns a view of the items from from, inclusive, to to, exclusive.
+// It panics if from is greater than to.
+func (s *SortedSet[K]) Range(from, to K) *SortedSet[K] {
+	if s.tree.compare(from, to) > 0 {
+		panic("from is greater than to")
+	}
+	lo := treeBound[K]{key: from, inclusive: true, set: true}
+	hi := treeBound[K]{key: to, set: true}
+	return &SortedSet[K]{tree: s.tree, bounds: s.tree.narrow(s.bounds, lo, hi)}
+}
+
+// Rank returns the number of items strictly less than the given one
+func (s *SortedSet[K]) Rank(item K) int {
+	rank := s.tree.countBelow(item, false) - s.tree.offset(s.bounds)
+	return min(max(rank, 0), s.Len())
+}
+
+// Select returns the item at the given position in ascending order
+func (s *SortedSet[K]) Select(index int) optional.Optional[K] {
+	if index < 0 || index >= s.Len() {
+		return optional.Empty[K]()
+	}
+	return keyOf(s.tree.at(s.tree.offset(s.bounds) + index))
+}
+
+func (s *SortedSet[K]) ToSet() Set[K] {
+	result := make(Set[K], s.Len())
+	for item := range s.Iter() {
+		result[item] = EmptyInstance
+	}
+	return result
+}
+
+// ToMap maps each item to the value chosen by valueSelector, like Set.ToMap
+func (s *SortedSet[K]) ToMap(valueSelector func(K) any) MutableMap[K, any] {
+	result := make(MutableMap[K, any], s.Len())
+	for item := range s.Iter() {
+		result[item] = valueSelector(item)
+	}
+	return result
+}
+
+func (s *SortedSet[K]) Also(fn func(*SortedSet[K])) *SortedSet[K] {
+	fn(s)
+	return s
+}
+
+func (s *SortedSet[K]) TakeIf(predicate func(*SortedSet[K]) bool) optional.Optional[*SortedSet[K]] {
+	if predicate(s) {
+		return optional.Of(s)
+	}
+	return optional.Empty[*SortedSet[K]]()
+}
+
+func (s *SortedSet[K]) TakeUnless(predicate func(*SortedSet[K]) bool) optional.Optional[*SortedSet[K]] {
+	if !predicate(s) {
+		return optional.Of(s)
+	}
+	return optional.Empty[*SortedSet[K]]()
+}
+
+func keyOf[K, V any](node *treeNode[K, V]) optional.Optional[K] {
+	if node == nil {
+		return optional.Empty[K]()
+	}
+	return optional.Of(node.key)
+}
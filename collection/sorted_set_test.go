@@ -0,0 +1,201 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestSortedSetAdd(t *testing.T) {
+	t.Run("keeps items ordered and unique", func(t *testing.T) {
+		s := collection.NewSortedSet[string]()
+
+		assert.True(t, s.Add("go"))
+		assert.True(t, s.Add("c"))
+		assert.False(t, s.Add("go"))
+		assert.Equal(t, collection.List[string]{"c", "go"}, s.Values())
+		assert.Equal(t, 2, s.Len())
+	})
+
+	t.Run("creates set from items", func(t *testing.T) {
+		s := collection.SortedSetOf(3, 1, 2, 3)
+
+		assert.Equal(t, []int{1, 2, 3}, s.ToList())
+	})
+
+	t.Run("orders by comparator", func(t *testing.T) {
+		s := collection.NewSortedSetFunc[int](func(a, b int) int { return b - a })
+		s.Add(1)
+		s.Add(3)
+		s.Add(2)
+
+		assert.Equal(t, collection.List[int]{3, 2, 1}, s.Values())
+	})
+}
+
+func TestSortedSetContainsRemove(t *testing.T) {
+	s := collection.SortedSetOf(1, 2, 3)
+	s.Remove(2)
+	s.Remove(5)
+
+	assert.True(t, s.Contains(1))
+	assert.False(t, s.Contains(2))
+	assert.Equal(t, 2, s.Len())
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func TestSortedSetString(t *testing.T) {
+	t.Run("is deterministic", func(t *testing.T) {
+		s := collection.SortedSetOf("zig", "go", "rust")
+
+		assert.Equal(t, "{go, rust, zig}", s.String())
+	})
+
+	t.Run("formats empty set", func(t *testing.T) {
+		assert.Equal(t, "{}", collection.NewSortedSet[int]().String())
+	})
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	a := collection.SortedSetOf(1, 2, 3, 4)
+	b := collection.SortedSetOf(3, 4, 5)
+
+	assert.Equal(t, collection.List[int]{1, 2, 3, 4, 5}, a.Union(b).Values())
+	assert.Equal(t, collection.List[int]{3, 4}, a.Intersect(b).Values())
+	assert.Equal(t, collection.List[int]{1, 2}, a.Subtract(b).Values())
+	assert.Equal(t, 4, a.Len())
+}
+
+func TestSortedSetFunctional(t *testing.T) {
+	s := collection.SortedSetOf(1, 2, 3, 4)
+	isEven := func(item int) bool { return item%2 == 0 }
+
+	assert.Equal(t, collection.List[int]{2, 4}, s.Filter(isEven).Values())
+	assert.True(t, s.Any(isEven))
+	assert.False(t, s.All(isEven))
+	assert.True(t, s.All(func(item int) bool { return item > 0 }))
+	assert.True(t, s.None(func(item int) bool { return item > 4 }))
+
+	var visited []int
+	s.ForEach(func(item int) {
+		visited = append(visited, item)
+	})
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+}
+
+func TestSortedSetNavigation(t *testing.T) {
+	s := collection.SortedSetOf(10, 20, 30)
+
+	assert.Equal(t, 10, s.First().GetValue())
+	assert.Equal(t, 30, s.Last().GetValue())
+	assert.Equal(t, 20, s.Floor(25).GetValue())
+	assert.Equal(t, 20, s.Floor(20).GetValue())
+	assert.True(t, s.Floor(5).IsEmpty())
+	assert.Equal(t, 30, s.Ceiling(25).GetValue())
+	assert.True(t, s.Ceiling(31).IsEmpty())
+	assert.Equal(t, 10, s.Lower(20).GetValue())
+	assert.Equal(t, 30, s.Higher(20).GetValue())
+
+	empty := collection.NewSortedSet[int]()
+	assert.True(t, empty.First().IsEmpty())
+	assert.True(t, empty.Last().IsEmpty())
+}
+
+func TestSortedSetRange(t *testing.T) {
+	t.Run("returns a half open view", func(t *testing.T) {
+		s := collection.SortedSetOf(1, 2, 3, 4, 5)
+		r := s.Range(2, 5)
+
+		assert.Equal(t, collection.List[int]{2, 3, 4}, r.Values())
+		assert.Equal(t, 2, r.First().GetValue())
+		assert.Equal(t, 4, r.Last().GetValue())
+		assert.False(t, r.Contains(5))
+	})
+
+	t.Run("shares storage with the set", func(t *testing.T) {
+		s := collection.SortedSetOf(1, 5, 9)
+		r := s.Range(2, 8)
+
+		s.Add(6)
+		r.Add(3)
+		assert.Equal(t, collection.List[int]{3, 5, 6}, r.Values())
+		assert.Equal(t, collection.List[int]{1, 3, 5, 6, 9}, s.Values())
+		assert.Panics(t, func() { r.Add(8) })
+	})
+
+	t.Run("panics on inverted bounds", func(t *testing.T) {
+		assert.Panics(t, func() { collection.SortedSetOf(1).Range(3, 2) })
+	})
+}
+
+func TestSortedSetRankSelect(t *testing.T) {
+	s := collection.SortedSetOf(10, 20, 30, 40)
+
+	t.Run("ranks items", func(t *testing.T) {
+		assert.Equal(t, 0, s.Rank(5))
+		assert.Equal(t, 0, s.Rank(10))
+		assert.Equal(t, 2, s.Rank(25))
+		assert.Equal(t, 3, s.Rank(40))
+		assert.Equal(t, 4, s.Rank(99))
+	})
+
+	t.Run("selects items by position", func(t *testing.T) {
+		assert.Equal(t, 10, s.Select(0).GetValue())
+		assert.Equal(t, 40, s.Select(3).GetValue())
+		assert.True(t, s.Select(4).IsEmpty())
+		assert.True(t, s.Select(-1).IsEmpty())
+	})
+
+	t.Run("ranks and selects within a range", func(t *testing.T) {
+		r := s.Range(20, 40)
+
+		assert.Equal(t, 0, r.Rank(10))
+		assert.Equal(t, 1, r.Rank(30))
+		assert.Equal(t, 2, r.Rank(50))
+		assert.Equal(t, 30, r.Select(1).GetValue())
+		assert.True(t, r.Select(2).IsEmpty())
+	})
+}
+
+func TestSortedSetIteration(t *testing.T) {
+	s := collection.SortedSetOf(3, 1, 2)
+
+	var forward, backward []int
+	for item := range s.Iter() {
+		forward = append(forward, item)
+	}
+	for item := range s.Backward() {
+		backward = append(backward, item)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, forward)
+	assert.Equal(t, []int{3, 2, 1}, backward)
+}
+
+func TestSortedSetConversions(t *testing.T) {
+	s := collection.SortedSetOf("a", "b")
+
+	assert.Equal(t, collection.Set[string]{"a": {}, "b": {}}, s.ToSet())
+}
+
+func TestSortedSetToMap(t *testing.T) {
+	s := collection.SortedSetOf("go", "zig")
+	lengths := s.ToMap(func(item string) any { return len(item) })
+	assert.MapEqual(t, collection.MutableMap[string, any]{"go": 2, "zig": 3}, lengths)
+	assert.Equal(t, 0, len(collection.SortedSetOf[int]().ToMap(func(int) any { return nil })))
+}
+
+func TestSortedSetScopeFunctions(t *testing.T) {
+	s := collection.SortedSetOf(1, 2)
+	called := false
+
+	assert.Equal(t, s, s.Also(func(*collection.SortedSet[int]) { called = true }))
+	assert.True(t, called)
+
+	hasTwo := func(s *collection.SortedSet[int]) bool { return s.Len() == 2 }
+	assert.True(t, s.TakeIf(hasTwo).IsPresent())
+	assert.True(t, s.TakeUnless(hasTwo).IsEmpty())
+}
@@ -0,0 +1,414 @@
+package collection
+
+import . "github.com/marlonbarreto-git/gollections/tomove/function"
+
+// tree is an AVL tree whose nodes also track their subtree size, which gives
+// O(log n) rank and select on top of the usual ordered lookups.
+// It backs SortedMap and SortedSet, and with an augment hook, IntervalTree.
+type tree[K, V any] struct {
+	root    *treeNode[K, V]
+	compare Comparator[K]
+	// augment, if set, recomputes extra data kept in a node's value from the node and its children.
+	// It runs whenever the node's subtree changes, children first.
+	augment func(*treeNode[K, V])
+}
+
+type treeNode[K, V any] struct {
+	key         K
+	value       V
+	left, right *treeNode[K, V]
+	height      int
+	size        int
+}
+
+// treeBound is one end of a key range; the zero value means unbounded
+type treeBound[K any] struct {
+	key       K
+	inclusive bool
+	set       bool
+}
+
+// treeRange restricts a tree to the keys between lo and hi, used by sorted views
+type treeRange[K any] struct {
+	lo, hi treeBound[K]
+}
+
+func newTree[K, V any](compare Comparator[K]) *tree[K, V] {
+	return &tree[K, V]{compare: compare}
+}
+
+func newAugmentedTree[K, V any](compare Comparator[K], augment func(*treeNode[K, V])) *tree[K, V] {
+	return &tree[K, V]{compare: compare, augment: augment}
+}
+
+func (t *tree[K, V]) len() int {
+	return t.root.subtreeSize()
+}
+
+func (t *tree[K, V]) get(key K) *treeNode[K, V] {
+	node := t.root
+	for node != nil {
+		switch c := t.compare(key, node.key); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// put inserts or replaces the value for key, returning true when the key was new
+func (t *tree[K, V]) put(key K, value V) bool {
+	var inserted bool
+	t.root, inserted = t.insert(t.root, key, value)
+	return inserted
+}
+
+// remove deletes key, returning the removed node or nil when absent
+func (t *tree[K, V]) remove(key K) *treeNode[K, V] {
+	var removed *treeNode[K, V]
+	t.root, removed = t.delete(t.root, key)
+	return removed
+}
+
+// floor returns the greatest node with a key below key, or equal to it when inclusive
+func (t *tree[K, V]) floor(key K, inclusive bool) *treeNode[K, V] {
+	var found *treeNode[K, V]
+	for node := t.root; node != nil; {
+		c := t.compare(node.key, key)
+		if c < 0 || (c == 0 && inclusive) {
+			found = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return found
+}
+
+// ceiling returns the least node with a key above key, or equal to it when inclusive
+func (t *tree[K, V]) ceiling(key K, inclusive bool) *treeNode[K, V] {
+	var found *treeNode[K, V]
+	for node := t.root; node != nil; {
+		c := t.compare(node.key, key)
+		if c > 0 || (c == 0 && inclusive) {
+			found = node
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return found
+}
+
+// countBelow returns how many keys are below key, counting key itself when inclusive
+func (t *tree[K, V]) countBelow(key K, inclusive bool) int {
+	count := 0
+	for node := t.root; node != nil; {
+		c := t.compare(node.key, key)
+		if c < 0 || (c == 0 && inclusive) {
+			count += node.left.subtreeSize() + 1
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return count
+}
+
+// at returns the node holding the index-th smallest key
+func (t *tree[K, V]) at(index int) *treeNode[K, V] {
+	node := t.root
+	for node != nil {
+		leftSize := node.left.subtreeSize()
+		switch {
+		case index < leftSize:
+			node = node.left
+		case index > leftSize:
+			index -= leftSize + 1
+			node = node.right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+func (t *tree[K, V]) tooLow(r treeRange[K], key K) bool {
+	if !r.lo.set {
+		return false
+	}
+	c := t.compare(key, r.lo.key)
+	return c < 0 || (c == 0 && !r.lo.inclusive)
+}
+
+func (t *tree[K, V]) tooHigh(r treeRange[K], key K) bool {
+	if !r.hi.set {
+		return false
+	}
+	c := t.compare(key, r.hi.key)
+	return c > 0 || (c == 0 && !r.hi.inclusive)
+}
+
+func (t *tree[K, V]) inRange(r treeRange[K], key K) bool {
+	return !t.tooLow(r, key) && !t.tooHigh(r, key)
+}
+
+func (t *tree[K, V]) first(r treeRange[K]) *treeNode[K, V] {
+	var node *treeNode[K, V]
+	if r.lo.set {
+		node = t.ceiling(r.lo.key, r.lo.inclusive)
+	} else {
+		node = t.root.min()
+	}
+	if node == nil || t.tooHigh(r, node.key) {
+		return nil
+	}
+	return node
+}
+
+func (t *tree[K, V]) last(r treeRange[K]) *treeNode[K, V] {
+	var node *treeNode[K, V]
+	if r.hi.set {
+		node = t.floor(r.hi.key, r.hi.inclusive)
+	} else {
+		node = t.root.max()
+	}
+	if node == nil || t.tooLow(r, node.key) {
+		return nil
+	}
+	return node
+}
+
+// floorIn is floor restricted to the range
+func (t *tree[K, V]) floorIn(r treeRange[K], key K, inclusive bool) *treeNode[K, V] {
+	if t.tooHigh(r, key) {
+		return t.last(r)
+	}
+	node := t.floor(key, inclusive)
+	if node == nil || t.tooLow(r, node.key) {
+		return nil
+	}
+	return node
+}
+
+// ceilingIn is ceiling restricted to the range
+func (t *tree[K, V]) ceilingIn(r treeRange[K], key K, inclusive bool) *treeNode[K, V] {
+	if t.tooLow(r, key) {
+		return t.first(r)
+	}
+	node := t.ceiling(key, inclusive)
+	if node == nil || t.tooHigh(r, node.key) {
+		return nil
+	}
+	return node
+}
+
+// offset returns how many keys of the tree sit below the range
+func (t *tree[K, V]) offset(r treeRange[K]) int {
+	if !r.lo.set {
+		return 0
+	}
+	return t.countBelow(r.lo.key, !r.lo.inclusive)
+}
+
+func (t *tree[K, V]) lenIn(r treeRange[K]) int {
+	upper := t.len()
+	if r.hi.set {
+		upper = t.countBelow(r.hi.key, r.hi.inclusive)
+	}
+	return max(upper-t.offset(r), 0)
+}
+
+// ascend visits the nodes in the range in increasing key order until visit returns false
+func (t *tree[K, V]) ascend(r treeRange[K], node *treeNode[K, V], visit func(*treeNode[K, V]) bool) bool {
+	if node == nil {
+		return true
+	}
+	if !t.tooLow(r, node.key) && !t.ascend(r, node.left, visit) {
+		return false
+	}
+	if t.inRange(r, node.key) && !visit(node) {
+		return false
+	}
+	if !t.tooHigh(r, node.key) {
+		return t.ascend(r, node.right, visit)
+	}
+	return true
+}
+
+// descend visits the nodes in the range in decreasing key order until visit returns false
+func (t *tree[K, V]) descend(r treeRange[K], node *treeNode[K, V], visit func(*treeNode[K, V]) bool) bool {
+	if node == nil {
+		return true
+	}
+	if !t.tooHigh(r, node.key) && !t.descend(r, node.right, visit) {
+		return false
+	}
+	if t.inRange(r, node.key) && !visit(node) {
+		return false
+	}
+	if !t.tooLow(r, node.key) {
+		return t.descend(r, node.left, visit)
+	}
+	return true
+}
+
+// narrow returns the intersection of the range with the given bounds
+func (t *tree[K, V]) narrow(r treeRange[K], lo, hi treeBound[K]) treeRange[K] {
+	if lo.set && (!r.lo.set || t.tighterLow(lo, r.lo)) {
+		r.lo = lo
+	}
+	if hi.set && (!r.hi.set || t.tighterHigh(hi, r.hi)) {
+		r.hi = hi
+	}
+	return r
+}
+
+func (t *tree[K, V]) tighterLow(candidate, current treeBound[K]) bool {
+	c := t.compare(candidate.key, current.key)
+	return c > 0 || (c == 0 && !candidate.inclusive)
+}
+
+func (t *tree[K, V]) tighterHigh(candidate, current treeBound[K]) bool {
+	c := t.compare(candidate.key, current.key)
+	return c < 0 || (c == 0 && !candidate.inclusive)
+}
+
+func (t *tree[K, V]) insert(node *treeNode[K, V], key K, value V) (*treeNode[K, V], bool) {
+	if node == nil {
+		node = &treeNode[K, V]{key: key, value: value}
+		t.update(node)
+		return node, true
+	}
+	var inserted bool
+	switch c := t.compare(key, node.key); {
+	case c < 0:
+		node.left, inserted = t.insert(node.left, key, value)
+	case c > 0:
+		node.right, inserted = t.insert(node.right, key, value)
+	default:
+		node.value = value
+		t.update(node)
+		return node, false
+	}
+	return t.rebalance(node), inserted
+}
+
+func (t *tree[K, V]) delete(node *treeNode[K, V], key K) (*treeNode[K, V], *treeNode[K, V]) {
+	if node == nil {
+		return nil, nil
+	}
+	var removed *treeNode[K, V]
+	switch c := t.compare(key, node.key); {
+	case c < 0:
+		node.left, removed = t.delete(node.left, key)
+	case c > 0:
+		node.right, removed = t.delete(node.right, key)
+	default:
+		removed = node
+		if node.left == nil {
+			return node.right, removed
+		}
+		if node.right == nil {
+			return node.left, removed
+		}
+		right, successor := t.removeMin(node.right)
+		successor.left, successor.right = node.left, right
+		node = successor
+	}
+	return t.rebalance(node), removed
+}
+
+func (n *treeNode[K, V]) subtreeSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *treeNode[K, V]) subtreeHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeNode[K, V]) min() *treeNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (n *treeNode[K, V]) max() *treeNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func (t *tree[K, V]) removeMin(n *treeNode[K, V]) (*treeNode[K, V], *treeNode[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var removed *treeNode[K, V]
+	n.left, removed = t.removeMin(n.left)
+	return t.rebalance(n), removed
+}
+
+func (t *tree[K, V]) update(n *treeNode[K, V]) {
+	n.height = max(n.left.subtreeHeight(), n.right.subtreeHeight()) + 1
+	n.size = n.left.subtreeSize() + n.right.subtreeSize() + 1
+	if t.augment != nil {
+		t.augment(n)
+	}
+}
+
+func (n *treeNode[K, V]) balanceFactor() int {
+	return n.left.subtreeHeight() - n.right.subtreeHeight()
+}
+
+func (t *tree[K, V]) rebalance(n *treeNode[K, V]) *treeNode[K, V] {
+	t.update(n)
+	switch balance := n.balanceFactor(); {
+	case balance > 1:
+		if n.left.balanceFactor() < 0 {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	case balance < -1:
+		if n.right.balanceFactor() > 0 {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+	return n
+}
+
+func (t *tree[K, V]) rotateRight(n *treeNode[K, V]) *treeNode[K, V] {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	t.update(n)
+	t.update(pivot)
+	return pivot
+}
+
+func (t *tree[K, V]) rotateLeft(n *treeNode[K, V]) *treeNode[K, V] {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	t.update(n)
+	t.update(pivot)
+	return pivot
+}
@@ -0,0 +1,301 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+
+	"github.com/marlonbarreto-git/gollections/sequence"
+	. "github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+// Trie is a map from strings to values stored as a compressed radix tree: keys sharing a prefix share
+// the nodes for it, and chains of single-child nodes are merged into one edge.
+// Prefix lookups only visit the matching subtree, and iteration is in lexicographic (byte) order.
+// The zero value is an empty Trie.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+// trieNode is reached through the edge labelled prefix; its children are kept sorted by their first byte
+type trieNode[V any] struct {
+	prefix   string
+	value    V
+	hasValue bool
+	children []*trieNode[V]
+}
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+func TrieOf[V any](pairs ...Pair[string, V]) *Trie[V] {
+	t := NewTrie[V]()
+	t.PutAll(pairs...)
+	return t
+}
+
+func (t *Trie[V]) Put(key string, value V) {
+	node := &t.root
+	for key != "" {
+		index, child := node.child(key[0])
+		if child == nil {
+			node.children = append(node.children, nil)
+			copy(node.children[index+1:], node.children[index:])
+			node.children[index] = &trieNode[V]{prefix: key}
+			node = node.children[index]
+			break
+		}
+
+		common := commonPrefixLen(child.prefix, key)
+		if common < len(child.prefix) {
+			split := &trieNode[V]{prefix: child.prefix[:common], children: []*trieNode[V]{child}}
+			child.prefix = child.prefix[common:]
+			node.children[index] = split
+			child = split
+		}
+		node, key = child, key[common:]
+	}
+
+	if !node.hasValue {
+		t.size++
+	}
+	node.value, node.hasValue = value, true
+}
+
+func (t *Trie[V]) PutAll(pairs ...Pair[string, V]) {
+	for _, pair := range pairs {
+		t.Put(pair.First(), pair.Second())
+	}
+}
+
+func (t *Trie[V]) Get(key string) optional.Optional[V] {
+	if node := t.find(key); node != nil {
+		return optional.Of(node.value)
+	}
+	return optional.Empty[V]()
+}
+
+// Lookup returns the value for the key and whether it was present
+func (t *Trie[V]) Lookup(key string) (value V, ok bool) {
+	if node := t.find(key); node != nil {
+		return node.value, true
+	}
+	return
+}
+
+func (t *Trie[V]) GetOrDefault(key string, defaultValue V) V {
+	if node := t.find(key); node != nil {
+		return node.value
+	}
+	return defaultValue
+}
+
+func (t *Trie[V]) ContainsKey(key string) bool {
+	return t.find(key) != nil
+}
+
+// Delete removes the key and reports whether it was present, merging nodes left with a single child
+func (t *Trie[V]) Delete(key string) bool {
+	if !t.root.delete(key) {
+		return false
+	}
+	t.size--
+	return true
+}
+
+func (t *Trie[V]) Clear() {
+	t.root, t.size = trieNode[V]{}, 0
+}
+
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+func (t *Trie[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// WithPrefix returns the entries whose keys start with prefix, in lexicographic order
+func (t *Trie[V]) WithPrefix(prefix string) sequence.Seq[Pair[string, V]] {
+	return sequence.FromIter(func(yield func(Pair[string, V]) bool) {
+		node, path := &t.root, ""
+		for rest := prefix; rest != ""; {
+			_, child := node.child(rest[0])
+			switch {
+			case child == nil:
+				return
+			case strings.HasPrefix(rest, child.prefix):
+				rest = rest[len(child.prefix):]
+			case strings.HasPrefix(child.prefix, rest):
+				rest = ""
+			default:
+				return
+			}
+			node, path = child, path+child.prefix
+		}
+
+		node.walk(path, func(key string, value V) bool {
+			return yield(PairOf(key, value))
+		})
+	})
+}
+
+// LongestPrefixOf returns the entry with the longest key that is a prefix of the given string,
+// like a routing table picking the most specific route
+func (t *Trie[V]) LongestPrefixOf(s string) optional.Optional[Pair[string, V]] {
+	best := optional.Empty[Pair[string, V]]()
+	node, consumed := &t.root, 0
+	for {
+		if node.hasValue {
+			best = optional.Of(PairOf(s[:consumed], node.value))
+		}
+		if consumed == len(s) {
+			return best
+		}
+		_, child := node.child(s[consumed])
+		if child == nil || !strings.HasPrefix(s[consumed:], child.prefix) {
+			return best
+		}
+		node, consumed = child, consumed+len(child.prefix)
+	}
+}
+
+// Iter returns an iterator over the entries in lexicographic order of their keys
+func (t *Trie[V]) Iter() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		t.root.walk("", yield)
+	}
+}
+
+func (t *Trie[V]) Keys() List[string] {
+	keys := make(List[string], 0, t.size)
+	for key := range t.Iter() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (t *Trie[V]) Values() List[V] {
+	values := make(List[V], 0, t.size)
+	for _, value := range t.Iter() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (t *Trie[V]) Entries() []Pair[string, V] {
+	entries := make([]Pair[string, V], 0, t.size)
+	for key, value := range t.Iter() {
+		entries = append(entries, PairOf(key, value))
+	}
+	return entries
+}
+
+func (t *Trie[V]) ForEach(consumer BiConsumer[string, V]) {
+	for key, value := range t.Iter() {
+		consumer(key, value)
+	}
+}
+
+func (t *Trie[V]) ToMap() MutableMap[string, V] {
+	result := make(MutableMap[string, V], t.size)
+	for key, value := range t.Iter() {
+		result[key] = value
+	}
+	return result
+}
+
+func (t *Trie[V]) String() string {
+	var str strings.Builder
+	str.WriteString("{")
+
+	first := true
+	for key, value := range t.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v: %v", key, value))
+		first = false
+	}
+
+	str.WriteString("}")
+	return str.String()
+}
+
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	node := &t.root
+	for key != "" {
+		_, child := node.child(key[0])
+		if child == nil || !strings.HasPrefix(key, child.prefix) {
+			return nil
+		}
+		node, key = child, key[len(child.prefix):]
+	}
+	if !node.hasValue {
+		return nil
+	}
+	return node
+}
+
+// child returns the child whose prefix starts with b, or nil and the index where it would be inserted
+func (n *trieNode[V]) child(b byte) (int, *trieNode[V]) {
+	index := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].prefix[0] >= b
+	})
+	if index < len(n.children) && n.children[index].prefix[0] == b {
+		return index, n.children[index]
+	}
+	return index, nil
+}
+
+func (n *trieNode[V]) delete(key string) bool {
+	if key == "" {
+		if !n.hasValue {
+			return false
+		}
+		var zero V
+		n.value, n.hasValue = zero, false
+		return true
+	}
+
+	index, child := n.child(key[0])
+	if child == nil || !strings.HasPrefix(key, child.prefix) || !child.delete(key[len(child.prefix):]) {
+		return false
+	}
+
+	if !child.hasValue {
+		switch len(child.children) {
+		case 0:
+			n.children = append(n.children[:index], n.children[index+1:]...)
+		case 1:
+			grandchild := child.children[0]
+			grandchild.prefix = child.prefix + grandchild.prefix
+			n.children[index] = grandchild
+		}
+	}
+	return true
+}
+
+func (n *trieNode[V]) walk(key string, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(key, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.walk(key+child.prefix, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func commonPrefixLen(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
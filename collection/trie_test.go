@@ -0,0 +1,161 @@
+package collection_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestTriePutGet(t *testing.T) {
+	t.Run("stores keys sharing prefixes", func(t *testing.T) {
+		var trie collection.Trie[int]
+		trie.Put("team", 1)
+		trie.Put("tea", 2)
+		trie.Put("ten", 3)
+		trie.Put("t", 4)
+		trie.Put("", 5)
+		trie.Put("tea", 20)
+
+		assert.Equal(t, 5, trie.Len())
+		assert.Equal(t, 1, trie.Get("team").GetValue())
+		assert.Equal(t, 20, trie.Get("tea").GetValue())
+		assert.Equal(t, 4, trie.Get("t").GetValue())
+		assert.Equal(t, 5, trie.Get("").GetValue())
+		assert.True(t, trie.Get("te").IsEmpty())
+		assert.True(t, trie.Get("teams").IsEmpty())
+		assert.True(t, trie.Get("x").IsEmpty())
+		assert.False(t, trie.ContainsKey("te"))
+		assert.Equal(t, 9, trie.GetOrDefault("te", 9))
+	})
+
+	t.Run("reports zero values through Lookup", func(t *testing.T) {
+		trie := collection.TrieOf(collection.PairOf("a", ""))
+		value, ok := trie.Lookup("a")
+		assert.Equal(t, "", value)
+		assert.True(t, ok)
+		_, ok = trie.Lookup("b")
+		assert.False(t, ok)
+	})
+}
+
+func TestTrieDelete(t *testing.T) {
+	trie := collection.TrieOf(
+		collection.PairOf("romane", 1), collection.PairOf("romanus", 2), collection.PairOf("romulus", 3),
+		collection.PairOf("rubens", 4), collection.PairOf("ruber", 5),
+	)
+
+	assert.False(t, trie.Delete("rom"))
+	assert.False(t, trie.Delete("romanes"))
+	assert.True(t, trie.Delete("romanus"))
+	assert.False(t, trie.Delete("romanus"))
+	assert.True(t, trie.Delete("ruber"))
+
+	assert.Equal(t, 3, trie.Len())
+	assert.Equal(t, collection.List[string]{"romane", "romulus", "rubens"}, trie.Keys())
+	assert.Equal(t, 4, trie.Get("rubens").GetValue())
+
+	trie.Clear()
+	assert.True(t, trie.IsEmpty())
+	assert.Equal(t, 0, len(trie.Keys()))
+}
+
+func TestTrieMatchesBuiltinMap(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	randomKey := func() string {
+		var key strings.Builder
+		for i := random.Intn(5); i > 0; i-- {
+			key.WriteByte("abc"[random.Intn(3)])
+		}
+		return key.String()
+	}
+
+	var trie collection.Trie[int]
+	expected := map[string]int{}
+	for i := 0; i < 2000; i++ {
+		key := randomKey()
+		if random.Intn(3) == 0 {
+			_, present := expected[key]
+			assert.Equal(t, present, trie.Delete(key))
+			delete(expected, key)
+		} else {
+			trie.Put(key, i)
+			expected[key] = i
+		}
+	}
+
+	keys := collection.MutableMap[string, int](expected).Keys()
+	sort.Strings(keys)
+	assert.Equal(t, keys, trie.Keys())
+	assert.MapEqual(t, expected, trie.ToMap())
+
+	prefix := "ab"
+	var withPrefix collection.List[string]
+	for _, key := range keys {
+		if strings.HasPrefix(key, prefix) {
+			withPrefix = append(withPrefix, key)
+		}
+	}
+	found := trie.WithPrefix(prefix).ToSlice()
+	assert.Equal(t, len(withPrefix), len(found))
+	for i, pair := range found {
+		assert.Equal(t, withPrefix[i], pair.First())
+		assert.Equal(t, expected[pair.First()], pair.Second())
+	}
+}
+
+func TestTrieWithPrefix(t *testing.T) {
+	trie := collection.TrieOf(
+		collection.PairOf("car", 1), collection.PairOf("card", 2), collection.PairOf("care", 3),
+		collection.PairOf("cat", 4), collection.PairOf("dog", 5),
+	)
+	keysWith := func(prefix string) []string {
+		var keys []string
+		trie.WithPrefix(prefix).ForEach(func(p collection.Pair[string, int]) {
+			keys = append(keys, p.First())
+		})
+		return keys
+	}
+
+	assert.Equal(t, []string{"car", "card", "care"}, keysWith("car"))
+	assert.Equal(t, []string{"car", "card", "care", "cat"}, keysWith("ca"))
+	assert.Equal(t, []string{"card"}, keysWith("card"))
+	assert.Equal(t, []string(nil), keysWith("cards"))
+	assert.Equal(t, []string(nil), keysWith("cb"))
+	assert.Equal(t, 5, len(keysWith("")))
+	assert.Equal(t, 2, trie.WithPrefix("car").Take(2).Count())
+}
+
+func TestTrieLongestPrefixOf(t *testing.T) {
+	routes := collection.TrieOf(
+		collection.PairOf("/", "root"), collection.PairOf("/api", "api"), collection.PairOf("/api/users", "users"),
+	)
+
+	assert.Equal(t, collection.PairOf("/api/users", "users"), routes.LongestPrefixOf("/api/users/42").GetValue())
+	assert.Equal(t, collection.PairOf("/api", "api"), routes.LongestPrefixOf("/api/orders").GetValue())
+	assert.Equal(t, collection.PairOf("/", "root"), routes.LongestPrefixOf("/static").GetValue())
+	assert.True(t, routes.LongestPrefixOf("api").IsEmpty())
+	assert.True(t, collection.NewTrie[int]().LongestPrefixOf("").IsEmpty())
+}
+
+func TestTrieIteration(t *testing.T) {
+	trie := collection.TrieOf(collection.PairOf("b", 2), collection.PairOf("a", 1), collection.PairOf("ab", 3))
+
+	assert.Equal(t, collection.List[int]{1, 3, 2}, trie.Values())
+	assert.Equal(t, []collection.Pair[string, int]{
+		collection.PairOf("a", 1), collection.PairOf("ab", 3), collection.PairOf("b", 2),
+	}, trie.Entries())
+	assert.Equal(t, "{a: 1, ab: 3, b: 2}", trie.String())
+
+	var visited []string
+	trie.ForEach(func(key string, _ int) { visited = append(visited, key) })
+	assert.Equal(t, []string{"a", "ab", "b"}, visited)
+
+	for key := range trie.Iter() {
+		assert.Equal(t, "a", key)
+		break
+	}
+}
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"github.com/marlonbarreto-git/gollections/collection"
+)
+
+// StronglyConnectedComponents splits the nodes into maximal groups in which every node reaches every other,
+// using Tarjan's algorithm. In undirected graphs these are the connected components.
+// Components come in reverse topological order: no edge leads from a component to one listed after it.
+func (g *Graph[N, E]) StronglyConnectedComponents() collection.List[collection.Set[N]] {
+	t := tarjan[N, E]{
+		graph:   g,
+		index:   collection.MutableMap[N, int]{},
+		lowLink: collection.MutableMap[N, int]{},
+		onStack: collection.Set[N]{},
+	}
+	for _, n := range g.Nodes() {
+		if !t.index.ContainsKey(n) {
+			t.visit(n)
+		}
+	}
+	return t.components
+}
+
+type tarjan[N comparable, E any] struct {
+	graph      *Graph[N, E]
+	index      collection.MutableMap[N, int]
+	lowLink    collection.MutableMap[N, int]
+	onStack    collection.Set[N]
+	stack      []N
+	components collection.List[collection.Set[N]]
+}
+
+func (t *tarjan[N, E]) visit(n N) {
+	t.index[n] = len(t.index)
+	t.lowLink[n] = t.index[n]
+	t.stack = append(t.stack, n)
+	t.onStack.Add(n)
+
+	for _, next := range t.graph.Successors(n) {
+		if !t.index.ContainsKey(next) {
+			t.visit(next)
+			t.lowLink[n] = min(t.lowLink[n], t.lowLink[next])
+		} else if t.onStack.Contains(next) {
+			t.lowLink[n] = min(t.lowLink[n], t.index[next])
+		}
+	}
+
+	if t.lowLink[n] != t.index[n] {
+		return
+	}
+	component := collection.Set[N]{}
+	for {
+		top := t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		t.onStack.Remove(top)
+		component.Add(top)
+		if top == n {
+			break
+		}
+	}
+	t.components = append(t.components, component)
+}
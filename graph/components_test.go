@@ -0,0 +1,38 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/graph"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	t.Run("directed graph", func(t *testing.T) {
+		g := dependencies([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}, [2]string{"c", "d"},
+			[2]string{"d", "e"}, [2]string{"e", "d"}, [2]string{"e", "f"})
+
+		assert.Equal(t, collection.List[collection.Set[string]]{
+			{"f": {}},
+			{"d": {}, "e": {}},
+			{"a": {}, "b": {}, "c": {}},
+		}, g.StronglyConnectedComponents())
+	})
+
+	t.Run("undirected graph", func(t *testing.T) {
+		g := graph.NewUndirected[int, struct{}]()
+		g.AddEdge(1, 2, struct{}{})
+		g.AddEdge(3, 4, struct{}{})
+		g.AddEdge(2, 5, struct{}{})
+
+		assert.Equal(t, collection.List[collection.Set[int]]{
+			{1: {}, 2: {}, 5: {}},
+			{3: {}, 4: {}},
+		}, g.StronglyConnectedComponents())
+	})
+
+	t.Run("empty graph", func(t *testing.T) {
+		assert.Equal(t, 0, len(graph.NewDirected[int, int]().StronglyConnectedComponents()))
+	})
+}
@@ -0,0 +1,243 @@
+// Package graph provides directed and undirected graphs with typed edge values, plus the usual
+// algorithms over them: traversal, topological sort, shortest paths, strongly connected components
+// and transitive reduction.
+//
+// Wherever a result has an order that is not dictated by the algorithm, nodes come in the order they were
+// first added to the graph, so results are deterministic.
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+)
+
+// Graph stores nodes and the edges between them, each edge carrying a value of type E such as a weight or label.
+// Use struct{} as E when edges carry nothing. There is at most one edge from a node to another.
+// A Graph is not safe for concurrent use when at least one goroutine modifies it.
+type Graph[N comparable, E any] struct {
+	nodes    collection.MutableMap[N, *node[N, E]]
+	directed bool
+	edges    int
+	added    int
+}
+
+type node[N comparable, E any] struct {
+	order int
+	out   collection.MutableMap[N, E]
+	// in is nil in undirected graphs, where out holds every neighbor
+	in collection.MutableMap[N, E]
+}
+
+// Edge is an edge of a graph with its value. Edges of undirected graphs have no direction, but are
+// reported with From being the endpoint added first.
+type Edge[N comparable, E any] struct {
+	From  N
+	To    N
+	Value E
+}
+
+func NewDirected[N comparable, E any]() *Graph[N, E] {
+	return &Graph[N, E]{nodes: collection.MutableMap[N, *node[N, E]]{}, directed: true}
+}
+
+func NewUndirected[N comparable, E any]() *Graph[N, E] {
+	return &Graph[N, E]{nodes: collection.MutableMap[N, *node[N, E]]{}}
+}
+
+func (g *Graph[N, E]) IsDirected() bool {
+	return g.directed
+}
+
+// AddNode adds a node without edges, returning false if it was already present
+func (g *Graph[N, E]) AddNode(n N) bool {
+	if g.nodes.ContainsKey(n) {
+		return false
+	}
+	added := &node[N, E]{order: g.added, out: collection.MutableMap[N, E]{}}
+	if g.directed {
+		added.in = collection.MutableMap[N, E]{}
+	}
+	g.nodes[n] = added
+	g.added++
+	return true
+}
+
+// AddEdge adds an edge, adding its nodes first if needed, and replaces the value of an existing edge
+func (g *Graph[N, E]) AddEdge(from, to N, value E) {
+	g.AddNode(from)
+	g.AddNode(to)
+	if !g.nodes[from].out.ContainsKey(to) {
+		g.edges++
+	}
+	g.nodes[from].out[to] = value
+	if g.directed {
+		g.nodes[to].in[from] = value
+	} else {
+		g.nodes[to].out[from] = value
+	}
+}
+
+// RemoveNode removes a node along with its edges
+func (g *Graph[N, E]) RemoveNode(n N) bool {
+	removed, ok := g.nodes[n]
+	if !ok {
+		return false
+	}
+	for to := range removed.out {
+		g.RemoveEdge(n, to)
+	}
+	for from := range removed.in {
+		g.RemoveEdge(from, n)
+	}
+	delete(g.nodes, n)
+	return true
+}
+
+func (g *Graph[N, E]) RemoveEdge(from, to N) bool {
+	if !g.HasEdge(from, to) {
+		return false
+	}
+	delete(g.nodes[from].out, to)
+	if g.directed {
+		delete(g.nodes[to].in, from)
+	} else {
+		delete(g.nodes[to].out, from)
+	}
+	g.edges--
+	return true
+}
+
+func (g *Graph[N, E]) HasNode(n N) bool {
+	return g.nodes.ContainsKey(n)
+}
+
+func (g *Graph[N, E]) HasEdge(from, to N) bool {
+	source, ok := g.nodes[from]
+	return ok && source.out.ContainsKey(to)
+}
+
+// Edge returns the value of the edge between the nodes, and whether there is one
+func (g *Graph[N, E]) Edge(from, to N) (E, bool) {
+	if source, ok := g.nodes[from]; ok {
+		value, ok := source.out[to]
+		return value, ok
+	}
+	var zero E
+	return zero, false
+}
+
+// Successors returns the nodes reached by an edge from the node. In undirected graphs these are its neighbors.
+func (g *Graph[N, E]) Successors(n N) collection.List[N] {
+	source, ok := g.nodes[n]
+	if !ok {
+		return nil
+	}
+	return ordered(g, source.out)
+}
+
+// Predecessors returns the nodes with an edge to the node. In undirected graphs these are its neighbors.
+func (g *Graph[N, E]) Predecessors(n N) collection.List[N] {
+	target, ok := g.nodes[n]
+	if !ok {
+		return nil
+	}
+	if !g.directed {
+		return ordered(g, target.out)
+	}
+	return ordered(g, target.in)
+}
+
+func (g *Graph[N, E]) OutDegree(n N) int {
+	if source, ok := g.nodes[n]; ok {
+		return source.out.Len()
+	}
+	return 0
+}
+
+func (g *Graph[N, E]) InDegree(n N) int {
+	target, ok := g.nodes[n]
+	if !ok {
+		return 0
+	}
+	if !g.directed {
+		return target.out.Len()
+	}
+	return target.in.Len()
+}
+
+func (g *Graph[N, E]) Nodes() collection.List[N] {
+	return ordered(g, g.nodes)
+}
+
+// Edges returns every edge, ordered by source node and then by target node. Edges of undirected graphs
+// are returned once.
+func (g *Graph[N, E]) Edges() collection.List[Edge[N, E]] {
+	edges := make(collection.List[Edge[N, E]], 0, g.edges)
+	for _, from := range g.Nodes() {
+		for _, to := range g.Successors(from) {
+			if !g.directed && g.nodes[to].order < g.nodes[from].order {
+				continue
+			}
+			edges = append(edges, Edge[N, E]{From: from, To: to, Value: g.nodes[from].out[to]})
+		}
+	}
+	return edges
+}
+
+func (g *Graph[N, E]) NodeCount() int {
+	return g.nodes.Len()
+}
+
+func (g *Graph[N, E]) EdgeCount() int {
+	return g.edges
+}
+
+// Copy returns a graph with the same nodes and edges, in the same order
+func (g *Graph[N, E]) Copy() *Graph[N, E] {
+	copied := g.empty()
+	for _, n := range g.Nodes() {
+		copied.AddNode(n)
+	}
+	for _, edge := range g.Edges() {
+		copied.AddEdge(edge.From, edge.To, edge.Value)
+	}
+	return copied
+}
+
+// String lists each node with its successors, like "a -> [b c]"
+func (g *Graph[N, E]) String() string {
+	var builder strings.Builder
+	arrow := " -> "
+	if !g.directed {
+		arrow = " -- "
+	}
+	for i, n := range g.Nodes() {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(fmt.Sprintf("%v%s%v", n, arrow, []N(g.Successors(n))))
+	}
+	return builder.String()
+}
+
+func (g *Graph[N, E]) empty() *Graph[N, E] {
+	if g.directed {
+		return NewDirected[N, E]()
+	}
+	return NewUndirected[N, E]()
+}
+
+// ordered returns the keys of a map of nodes in the order the nodes were added
+func ordered[N comparable, V, E any](g *Graph[N, E], nodes collection.MutableMap[N, V]) collection.List[N] {
+	keys := make(collection.List[N], 0, len(nodes))
+	for n := range nodes {
+		keys = append(keys, n)
+	}
+	slices.SortFunc(keys, func(a, b N) int {
+		return g.nodes[a].order - g.nodes[b].order
+	})
+	return keys
+}
@@ -0,0 +1,90 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/graph"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestDirectedGraph(t *testing.T) {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 2)
+	g.AddEdge("c", "b", 3)
+	assert.True(t, g.AddNode("d"))
+	assert.False(t, g.AddNode("a"))
+
+	t.Run("tracks nodes and edges", func(t *testing.T) {
+		assert.True(t, g.IsDirected())
+		assert.Equal(t, 4, g.NodeCount())
+		assert.Equal(t, 3, g.EdgeCount())
+		assert.Equal(t, collection.List[string]{"a", "b", "c", "d"}, g.Nodes())
+		assert.True(t, g.HasEdge("a", "b"))
+		assert.False(t, g.HasEdge("b", "a"))
+
+		value, ok := g.Edge("c", "b")
+		assert.True(t, ok)
+		assert.Equal(t, 3, value)
+		_, ok = g.Edge("x", "b")
+		assert.False(t, ok)
+	})
+
+	t.Run("knows successors and predecessors", func(t *testing.T) {
+		assert.Equal(t, collection.List[string]{"b", "c"}, g.Successors("a"))
+		assert.Equal(t, collection.List[string]{"a", "c"}, g.Predecessors("b"))
+		assert.Equal(t, 2, g.OutDegree("a"))
+		assert.Equal(t, 2, g.InDegree("b"))
+		assert.Equal(t, 0, g.InDegree("missing"))
+		assert.Equal(t, 0, len(g.Successors("missing")))
+	})
+
+	t.Run("lists edges in node order", func(t *testing.T) {
+		assert.Equal(t, collection.List[graph.Edge[string, int]]{
+			{From: "a", To: "b", Value: 1},
+			{From: "a", To: "c", Value: 2},
+			{From: "c", To: "b", Value: 3},
+		}, g.Edges())
+		assert.Equal(t, "a -> [b c]\nb -> []\nc -> [b]\nd -> []", g.String())
+	})
+
+	t.Run("replaces and removes edges and nodes", func(t *testing.T) {
+		copied := g.Copy()
+		copied.AddEdge("a", "b", 10)
+		assert.Equal(t, 3, copied.EdgeCount())
+		value, _ := copied.Edge("a", "b")
+		assert.Equal(t, 10, value)
+		value, _ = g.Edge("a", "b")
+		assert.Equal(t, 1, value)
+
+		assert.True(t, copied.RemoveEdge("a", "c"))
+		assert.False(t, copied.RemoveEdge("a", "c"))
+		assert.True(t, copied.RemoveNode("b"))
+		assert.False(t, copied.RemoveNode("b"))
+		assert.Equal(t, 0, copied.EdgeCount())
+		assert.Equal(t, collection.List[string]{"a", "c", "d"}, copied.Nodes())
+		assert.Equal(t, 0, copied.OutDegree("a"))
+	})
+}
+
+func TestUndirectedGraph(t *testing.T) {
+	g := graph.NewUndirected[int, struct{}]()
+	g.AddEdge(1, 2, struct{}{})
+	g.AddEdge(3, 1, struct{}{})
+	g.AddEdge(2, 1, struct{}{})
+
+	assert.False(t, g.IsDirected())
+	assert.Equal(t, 2, g.EdgeCount())
+	assert.True(t, g.HasEdge(2, 1))
+	assert.True(t, g.HasEdge(1, 3))
+	assert.Equal(t, collection.List[int]{2, 3}, g.Successors(1))
+	assert.Equal(t, collection.List[int]{2, 3}, g.Predecessors(1))
+	assert.Equal(t, 2, g.InDegree(1))
+	assert.Equal(t, collection.List[graph.Edge[int, struct{}]]{{From: 1, To: 2}, {From: 1, To: 3}}, g.Edges())
+	assert.Equal(t, "1 -- [2 3]\n2 -- [1]\n3 -- [1]", g.String())
+
+	assert.True(t, g.RemoveNode(1))
+	assert.Equal(t, 0, g.EdgeCount())
+	assert.Equal(t, 0, g.OutDegree(2))
+}
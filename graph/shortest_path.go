@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/tomove/types/numbers"
+)
+
+// ShortestPath finds the cheapest path between two nodes with Dijkstra's algorithm, where weight gives the cost of
+// each edge. It returns the nodes of the path, both ends included, and its total cost, or false if to is not
+// reachable from from. Weights must not be negative; ShortestPath panics when it meets one.
+func ShortestPath[N comparable, E any, W numbers.Number](g *Graph[N, E], from, to N, weight func(E) W) (collection.List[N], W, bool) {
+	distances, previous := dijkstra(g, from, &to, weight)
+	cost, ok := distances[to]
+	if !ok {
+		return nil, cost, false
+	}
+
+	path := collection.List[N]{to}
+	for current := to; current != from; {
+		current = previous[current]
+		path = append(path, current)
+	}
+	slices.Reverse(path)
+	return path, cost, true
+}
+
+// ShortestDistances returns the cost of the cheapest path from a node to every node reachable from it,
+// itself included with a cost of zero. Weights must not be negative, as in ShortestPath.
+func ShortestDistances[N comparable, E any, W numbers.Number](g *Graph[N, E], from N, weight func(E) W) collection.MutableMap[N, W] {
+	distances, _ := dijkstra(g, from, nil, weight)
+	return distances
+}
+
+type dijkstraEntry[N any, W numbers.Number] struct {
+	node     N
+	distance W
+}
+
+// dijkstra computes the shortest distances from a node and the previous node on each shortest path.
+// With a target, it stops once the target's distance is final.
+func dijkstra[N comparable, E any, W numbers.Number](g *Graph[N, E], from N, target *N, weight func(E) W) (collection.MutableMap[N, W], collection.MutableMap[N, N]) {
+	distances := collection.MutableMap[N, W]{}
+	previous := collection.MutableMap[N, N]{}
+	if !g.HasNode(from) {
+		return distances, previous
+	}
+
+	queue := collection.NewPriorityQueue(func(a, b *dijkstraEntry[N, W]) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
+	handles := map[N]*collection.QueueHandle[*dijkstraEntry[N, W]]{}
+	done := collection.Set[N]{}
+
+	distances[from] = 0
+	handles[from] = queue.Push(&dijkstraEntry[N, W]{node: from})
+	for !queue.IsEmpty() {
+		current := queue.Pop().GetValue()
+		done.Add(current.node)
+		if target != nil && current.node == *target {
+			break
+		}
+
+		for _, next := range g.Successors(current.node) {
+			if done.Contains(next) {
+				continue
+			}
+			cost := weight(g.nodes[current.node].out[next])
+			if cost < 0 {
+				panic(fmt.Sprintf("negative edge weight: %v", cost))
+			}
+			distance := current.distance + cost
+			if known, ok := distances[next]; ok && known <= distance {
+				continue
+			}
+			distances[next] = distance
+			previous[next] = current.node
+			entry := &dijkstraEntry[N, W]{node: next, distance: distance}
+			if handle, ok := handles[next]; ok {
+				queue.Update(handle, entry)
+			} else {
+				handles[next] = queue.Push(entry)
+			}
+		}
+	}
+
+	if target != nil {
+		for n := range distances {
+			if !done.Contains(n) {
+				delete(distances, n)
+			}
+		}
+	}
+	return distances, previous
+}
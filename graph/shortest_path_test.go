@@ -0,0 +1,83 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/graph"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+type road struct {
+	km   float64
+	toll bool
+}
+
+func roads() *graph.Graph[string, road] {
+	g := graph.NewUndirected[string, road]()
+	g.AddEdge("home", "bridge", road{km: 4})
+	g.AddEdge("home", "highway", road{km: 2, toll: true})
+	g.AddEdge("highway", "office", road{km: 3, toll: true})
+	g.AddEdge("bridge", "office", road{km: 2})
+	g.AddEdge("bridge", "mall", road{km: 1})
+	g.AddNode("island")
+	return g
+}
+
+func km(r road) float64 { return r.km }
+
+func TestShortestPath(t *testing.T) {
+	g := roads()
+
+	t.Run("finds the cheapest path", func(t *testing.T) {
+		path, cost, ok := graph.ShortestPath(g, "home", "office", km)
+		assert.True(t, ok)
+		assert.Equal(t, collection.List[string]{"home", "highway", "office"}, path)
+		assert.Equal(t, 5.0, cost)
+	})
+
+	t.Run("uses any weight", func(t *testing.T) {
+		tolls := func(r road) int {
+			if r.toll {
+				return 10
+			}
+			return 1
+		}
+		path, cost, ok := graph.ShortestPath(g, "home", "office", tolls)
+		assert.True(t, ok)
+		assert.Equal(t, collection.List[string]{"home", "bridge", "office"}, path)
+		assert.Equal(t, 2, cost)
+	})
+
+	t.Run("path to itself", func(t *testing.T) {
+		path, cost, ok := graph.ShortestPath(g, "mall", "mall", km)
+		assert.True(t, ok)
+		assert.Equal(t, collection.List[string]{"mall"}, path)
+		assert.Equal(t, 0.0, cost)
+	})
+
+	t.Run("unreachable or unknown nodes", func(t *testing.T) {
+		_, _, ok := graph.ShortestPath(g, "home", "island", km)
+		assert.False(t, ok)
+		_, _, ok = graph.ShortestPath(g, "nowhere", "home", km)
+		assert.False(t, ok)
+	})
+
+	t.Run("panics on negative weights", func(t *testing.T) {
+		negative := graph.NewDirected[int, int]()
+		negative.AddEdge(1, 2, -1)
+		assert.Panics(t, func() {
+			graph.ShortestPath(negative, 1, 2, func(w int) int { return w })
+		})
+	})
+}
+
+func TestShortestDistances(t *testing.T) {
+	assert.Equal(t, collection.MutableMap[string, float64]{
+		"home":    0,
+		"highway": 2,
+		"bridge":  4,
+		"office":  5,
+		"mall":    5,
+	}, graph.ShortestDistances(roads(), "home", km))
+}
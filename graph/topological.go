@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+)
+
+var (
+	CycleError      = errors.New("cycle detected")
+	UndirectedError = errors.New("graph is undirected")
+)
+
+// TopologicalSort orders the nodes so that every edge goes from an earlier node to a later one, using Kahn's algorithm.
+// Among nodes whose order is free, those added first come first. If the graph has a cycle, the error wraps
+// CycleError and names the nodes of one cycle, like "cycle detected: a -> b -> a".
+// Undirected graphs are rejected with UndirectedError.
+func (g *Graph[N, E]) TopologicalSort() (collection.List[N], error) {
+	if !g.directed {
+		return nil, UndirectedError
+	}
+
+	nodes := g.Nodes()
+	inDegree := make(collection.MutableMap[N, int], len(nodes))
+	var ready collection.List[N]
+	for _, n := range nodes {
+		inDegree[n] = g.nodes[n].in.Len()
+		if inDegree[n] == 0 {
+			ready = append(ready, n)
+		}
+	}
+
+	sorted := make(collection.List[N], 0, len(nodes))
+	for len(ready) > 0 {
+		current := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, current)
+		for _, next := range g.Successors(current) {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+
+	if len(sorted) < len(nodes) {
+		return nil, g.cycleError(inDegree)
+	}
+	return sorted, nil
+}
+
+// cycleError finds a cycle among the nodes left with incoming edges after Kahn's algorithm. Each of them
+// has a predecessor also left, so walking predecessors must eventually repeat a node.
+// The cycle is reported starting from its node added first.
+func (g *Graph[N, E]) cycleError(inDegree collection.MutableMap[N, int]) error {
+	var start N
+	for _, n := range g.Nodes() {
+		if inDegree[n] > 0 {
+			start = n
+			break
+		}
+	}
+
+	position := collection.MutableMap[N, int]{}
+	var walk []N
+	current := start
+	for {
+		if at, ok := position[current]; ok {
+			walk = walk[at:]
+			break
+		}
+		position[current] = len(walk)
+		walk = append(walk, current)
+		for _, previous := range g.Predecessors(current) {
+			if inDegree[previous] > 0 {
+				current = previous
+				break
+			}
+		}
+	}
+
+	slices.Reverse(walk)
+	first := 0
+	for i, n := range walk {
+		if g.nodes[n].order < g.nodes[walk[first]].order {
+			first = i
+		}
+	}
+	walk = slices.Concat(walk[first:], walk[:first])
+	names := make([]string, 0, len(walk)+1)
+	for _, n := range walk {
+		names = append(names, fmt.Sprint(n))
+	}
+	names = append(names, fmt.Sprint(walk[0]))
+	return fmt.Errorf("%w: %s", CycleError, strings.Join(names, " -> "))
+}
+
+// TransitiveReduction returns a graph with the same nodes and reachability but as few edges as possible: every edge
+// from a to b is dropped when b is also reachable from a through other nodes. Kept edges keep their values.
+// It is only defined for directed acyclic graphs, so it fails like TopologicalSort otherwise.
+func (g *Graph[N, E]) TransitiveReduction() (*Graph[N, E], error) {
+	if _, err := g.TopologicalSort(); err != nil {
+		return nil, err
+	}
+
+	reduced := NewDirected[N, E]()
+	for _, n := range g.Nodes() {
+		reduced.AddNode(n)
+	}
+	for _, from := range g.Nodes() {
+		successors := g.Successors(from)
+		indirect := collection.Set[N]{}
+		for _, next := range successors {
+			g.collectDescendants(next, indirect)
+		}
+		for _, to := range successors {
+			if !indirect.Contains(to) {
+				reduced.AddEdge(from, to, g.nodes[from].out[to])
+			}
+		}
+	}
+	return reduced, nil
+}
+
+// collectDescendants adds to seen every node reachable from n by at least one edge
+func (g *Graph[N, E]) collectDescendants(n N, seen collection.Set[N]) {
+	for next := range g.nodes[n].out {
+		if seen.Add(next) {
+			g.collectDescendants(next, seen)
+		}
+	}
+}
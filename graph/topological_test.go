@@ -0,0 +1,80 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/graph"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func dependencies(edges ...[2]string) *graph.Graph[string, struct{}] {
+	g := graph.NewDirected[string, struct{}]()
+	for _, edge := range edges {
+		g.AddEdge(edge[0], edge[1], struct{}{})
+	}
+	return g
+}
+
+func TestTopologicalSort(t *testing.T) {
+	t.Run("orders build steps", func(t *testing.T) {
+		g := dependencies([2]string{"fetch", "compile"}, [2]string{"generate", "compile"}, [2]string{"compile", "test"},
+			[2]string{"compile", "package"}, [2]string{"test", "package"})
+		g.AddNode("lint")
+
+		sorted, err := g.TopologicalSort()
+		assert.NoError(t, err)
+		assert.Equal(t, collection.List[string]{"fetch", "generate", "lint", "compile", "test", "package"}, sorted)
+	})
+
+	t.Run("reports a cycle", func(t *testing.T) {
+		g := dependencies([2]string{"start", "a"}, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"},
+			[2]string{"c", "end"})
+
+		sorted, err := g.TopologicalSort()
+		assert.Equal(t, 0, len(sorted))
+		assert.True(t, errors.Is(err, graph.CycleError))
+		assert.Equal(t, "cycle detected: a -> b -> c -> a", err.Error())
+	})
+
+	t.Run("reports a cycle after its entry point", func(t *testing.T) {
+		g := graph.NewDirected[string, struct{}]()
+		g.AddNode("after")
+		g.AddEdge("b", "after", struct{}{})
+		g.AddEdge("a", "b", struct{}{})
+		g.AddEdge("b", "a", struct{}{})
+
+		_, err := g.TopologicalSort()
+		assert.Equal(t, "cycle detected: b -> a -> b", err.Error())
+	})
+
+	t.Run("reports a self loop", func(t *testing.T) {
+		_, err := dependencies([2]string{"a", "a"}).TopologicalSort()
+		assert.Equal(t, "cycle detected: a -> a", err.Error())
+	})
+
+	t.Run("rejects undirected graphs", func(t *testing.T) {
+		_, err := graph.NewUndirected[int, int]().TopologicalSort()
+		assert.ErrorIs(t, err, graph.UndirectedError)
+	})
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	g := dependencies([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"a", "c"}, [2]string{"c", "d"},
+		[2]string{"a", "d"}, [2]string{"b", "d"}, [2]string{"a", "e"})
+
+	reduced, err := g.TransitiveReduction()
+	assert.NoError(t, err)
+	assert.Equal(t, collection.List[graph.Edge[string, struct{}]]{
+		{From: "a", To: "b"},
+		{From: "a", To: "e"},
+		{From: "b", To: "c"},
+		{From: "c", To: "d"},
+	}, reduced.Edges())
+	assert.Equal(t, g.Nodes(), reduced.Nodes())
+	assert.Equal(t, 7, g.EdgeCount())
+
+	_, err = dependencies([2]string{"a", "b"}, [2]string{"b", "a"}).TransitiveReduction()
+	assert.True(t, errors.Is(err, graph.CycleError))
+}
@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"slices"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/sequence"
+)
+
+// BFS lazily visits the nodes reachable from start in breadth-first order, start included.
+// The sequence is empty if start is not in the graph. The graph must not be modified while iterating.
+func (g *Graph[N, E]) BFS(start N) sequence.Seq[N] {
+	return sequence.FromIter(func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := collection.Set[N]{start: {}}
+		queue := collection.List[N]{start}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			if !yield(current) {
+				return
+			}
+			for _, next := range g.Successors(current) {
+				if visited.Add(next) {
+					queue = append(queue, next)
+				}
+			}
+		}
+	})
+}
+
+// DFS lazily visits the nodes reachable from start in depth-first preorder, start included.
+// The sequence is empty if start is not in the graph. The graph must not be modified while iterating.
+func (g *Graph[N, E]) DFS(start N) sequence.Seq[N] {
+	return sequence.FromIter(func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := collection.Set[N]{}
+		stack := collection.List[N]{start}
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !visited.Add(current) {
+				continue
+			}
+			if !yield(current) {
+				return
+			}
+			successors := g.Successors(current)
+			for _, next := range slices.Backward(successors) {
+				if !visited.Contains(next) {
+					stack = append(stack, next)
+				}
+			}
+		}
+	})
+}
+
+// Reachable returns whether there is a path from one node to another. A node reaches itself.
+func (g *Graph[N, E]) Reachable(from, to N) bool {
+	return g.HasNode(to) && g.BFS(from).Contains(to)
+}
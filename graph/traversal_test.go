@@ -0,0 +1,48 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/graph"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func tree() *graph.Graph[string, struct{}] {
+	g := graph.NewDirected[string, struct{}]()
+	for _, edge := range [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"b", "e"}, {"c", "f"}, {"e", "a"}} {
+		g.AddEdge(edge[0], edge[1], struct{}{})
+	}
+	g.AddNode("lonely")
+	return g
+}
+
+func TestTraversal(t *testing.T) {
+	g := tree()
+
+	t.Run("BFS visits level by level", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, g.BFS("a").ToSlice())
+		assert.Equal(t, []string{"c", "f"}, g.BFS("c").ToSlice())
+	})
+
+	t.Run("DFS visits branch by branch", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "d", "e", "c", "f"}, g.DFS("a").ToSlice())
+		assert.Equal(t, []string{"e", "a", "b", "d", "c", "f"}, g.DFS("e").ToSlice())
+	})
+
+	t.Run("traversals are lazy", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b"}, g.BFS("a").Take(2).ToSlice())
+		assert.Equal(t, []string{"a", "b", "d"}, g.DFS("a").Take(3).ToSlice())
+	})
+
+	t.Run("unknown start is empty", func(t *testing.T) {
+		assert.Equal(t, 0, g.BFS("missing").Count())
+		assert.Equal(t, 0, g.DFS("missing").Count())
+	})
+
+	t.Run("reachability", func(t *testing.T) {
+		assert.True(t, g.Reachable("e", "f"))
+		assert.True(t, g.Reachable("lonely", "lonely"))
+		assert.False(t, g.Reachable("f", "a"))
+		assert.False(t, g.Reachable("a", "lonely"))
+	})
+}
@@ -0,0 +1,11 @@
+package immutable
+
+// SetHashMask narrows every key hash to the given bits so tests can force collisions.
+// It returns a function restoring the full hash.
+func SetHashMask(mask uint64) (restore func()) {
+	previous := hashMask
+	hashMask = mask
+	return func() {
+		hashMask = previous
+	}
+}
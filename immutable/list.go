@@ -0,0 +1,291 @@
+// Package immutable provides persistent collections: every update returns a new version
+// that shares most of its structure with the old one, which stays valid and unchanged.
+// Values of these types are safe to share between goroutines without copying.
+package immutable
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/sequence"
+	"github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+const (
+	listBits  = 5
+	listWidth = 1 << listBits
+	listMask  = listWidth - 1
+)
+
+// List is a persistent vector: a 32-way trie of leaves plus a tail holding the last elements.
+// Get, Set and Append run in O(log32 n), which is effectively constant, and Slice shares the
+// existing trie instead of copying it. Like a Go slice, a sliced list keeps the elements before its start reachable.
+// The zero value is an empty list.
+type List[T any] struct {
+	root   *listNode[T]
+	tail   []T
+	size   int
+	shift  uint
+	offset int
+}
+
+type listNode[T any] struct {
+	children []*listNode[T]
+	values   []T
+}
+
+func Empty[T any]() List[T] {
+	return List[T]{}
+}
+
+func Of[T any](items ...T) List[T] {
+	return From(items)
+}
+
+// From creates a List holding a copy of the given items
+func From[T any](items []T) List[T] {
+	var list List[T]
+	for len(items) > 0 {
+		n := min(listWidth, len(items))
+		if len(list.tail) == listWidth {
+			list = list.pushTail()
+		}
+		list.tail = append([]T(nil), items[:n]...)
+		list.size += n
+		items = items[n:]
+	}
+	return list
+}
+
+func (l List[T]) Len() int {
+	return l.size - l.offset
+}
+
+func (l List[T]) IsEmpty() bool {
+	return l.Len() == 0
+}
+
+// Get returns the item at the index, panicking if it is out of range
+func (l List[T]) Get(index int) T {
+	l.checkIndex(index)
+	chunk, start := l.chunkAt(index + l.offset)
+	return chunk[index+l.offset-start]
+}
+
+func (l List[T]) ElementAt(index int) optional.Optional[T] {
+	if index < 0 || index >= l.Len() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(l.Get(index))
+}
+
+func (l List[T]) First() optional.Optional[T] {
+	return l.ElementAt(0)
+}
+
+func (l List[T]) Last() optional.Optional[T] {
+	return l.ElementAt(l.Len() - 1)
+}
+
+// Set returns a new list with the item at the index replaced, panicking if the index is out of range
+func (l List[T]) Set(index int, item T) List[T] {
+	l.checkIndex(index)
+	at := index + l.offset
+	if at >= l.tailOffset() {
+		tail := append([]T(nil), l.tail...)
+		tail[at-l.tailOffset()] = item
+		l.tail = tail
+		return l
+	}
+	l.root = setIn(l.root, l.shift, at, item)
+	return l
+}
+
+func setIn[T any](node *listNode[T], shift uint, index int, item T) *listNode[T] {
+	if shift == 0 {
+		values := append([]T(nil), node.values...)
+		values[index&listMask] = item
+		return &listNode[T]{values: values}
+	}
+	children := append([]*listNode[T](nil), node.children...)
+	sub := (index >> shift) & listMask
+	children[sub] = setIn(children[sub], shift-listBits, index, item)
+	return &listNode[T]{children: children}
+}
+
+// Append returns a new list with the item added at the end
+func (l List[T]) Append(item T) List[T] {
+	if len(l.tail) == listWidth {
+		l = l.pushTail()
+	}
+	tail := make([]T, len(l.tail), len(l.tail)+1)
+	copy(tail, l.tail)
+	l.tail = append(tail, item)
+	l.size++
+	return l
+}
+
+func (l List[T]) Plus(item T) List[T] {
+	return l.Append(item)
+}
+
+func (l List[T]) PlusAll(items List[T]) List[T] {
+	for item := range items.Iter() {
+		l = l.Append(item)
+	}
+	return l
+}
+
+// pushTail moves the full tail into the trie, leaving the list with an empty tail
+func (l List[T]) pushTail() List[T] {
+	leaf := &listNode[T]{values: l.tail}
+	switch inTree := l.tailOffset(); {
+	case l.root == nil:
+		l.root, l.shift = &listNode[T]{children: []*listNode[T]{leaf}}, listBits
+	case inTree == 1<<(l.shift+listBits):
+		l.root = &listNode[T]{children: []*listNode[T]{l.root, newPath(l.shift, leaf)}}
+		l.shift += listBits
+	default:
+		l.root = pushLeaf(l.root, l.shift, inTree, leaf)
+	}
+	l.tail = nil
+	return l
+}
+
+func pushLeaf[T any](node *listNode[T], shift uint, index int, leaf *listNode[T]) *listNode[T] {
+	children := append([]*listNode[T](nil), node.children...)
+	sub := (index >> shift) & listMask
+	switch {
+	case shift == listBits:
+		children = append(children, leaf)
+	case sub < len(children):
+		children[sub] = pushLeaf(children[sub], shift-listBits, index, leaf)
+	default:
+		children = append(children, newPath(shift-listBits, leaf))
+	}
+	return &listNode[T]{children: children}
+}
+
+func newPath[T any](shift uint, leaf *listNode[T]) *listNode[T] {
+	if shift == 0 {
+		return leaf
+	}
+	return &listNode[T]{children: []*listNode[T]{newPath(shift-listBits, leaf)}}
+}
+
+// Slice returns the items from start (inclusive) to end (exclusive) as a new list sharing this one's structure.
+// It panics if the bounds are invalid, like slicing a Go slice.
+func (l List[T]) Slice(start, end int) List[T] {
+	if start < 0 || end < start || end > l.Len() {
+		panic(fmt.Sprintf("slice bounds out of range [%d:%d] with length %d", start, end, l.Len()))
+	}
+	if start == end {
+		return List[T]{}
+	}
+	sliced := l.truncate(l.offset + end)
+	sliced.offset = l.offset + start
+	return sliced
+}
+
+// truncate keeps the first size elements of the underlying trie and tail
+func (l List[T]) truncate(size int) List[T] {
+	if size > l.tailOffset() {
+		l.tail = l.tail[: size-l.tailOffset() : size-l.tailOffset()]
+		l.size = size
+		return l
+	}
+
+	chunk, start := l.chunkAt(size - 1)
+	truncated := List[T]{tail: chunk[: size-start : size-start], size: size}
+	if start > 0 {
+		truncated.root, truncated.shift = takeTree(l.root, l.shift, start), l.shift
+		for truncated.shift > listBits && len(truncated.root.children) == 1 {
+			truncated.root, truncated.shift = truncated.root.children[0], truncated.shift-listBits
+		}
+	}
+	return truncated
+}
+
+// takeTree returns a node holding only the first count elements of the given one, count being a multiple of the leaf width
+func takeTree[T any](node *listNode[T], shift uint, count int) *listNode[T] {
+	if shift == 0 {
+		return node
+	}
+	keep := (count + 1<<shift - 1) >> shift
+	children := append([]*listNode[T](nil), node.children[:keep]...)
+	children[keep-1] = takeTree(children[keep-1], shift-listBits, count-(keep-1)<<shift)
+	return &listNode[T]{children: children}
+}
+
+func (l List[T]) tailOffset() int {
+	return l.size - len(l.tail)
+}
+
+// chunkAt returns the leaf or tail holding the absolute index, along with the absolute index of its first element
+func (l List[T]) chunkAt(index int) ([]T, int) {
+	if index >= l.tailOffset() {
+		return l.tail, l.tailOffset()
+	}
+	node := l.root
+	for shift := l.shift; shift > 0; shift -= listBits {
+		node = node.children[(index>>shift)&listMask]
+	}
+	return node.values, index &^ listMask
+}
+
+func (l List[T]) checkIndex(index int) {
+	if index < 0 || index >= l.Len() {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.Len()))
+	}
+}
+
+// Iter returns an iterator over the items, walking the trie one leaf at a time
+func (l List[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for at := l.offset; at < l.size; {
+			chunk, start := l.chunkAt(at)
+			for _, item := range chunk[at-start:] {
+				if !yield(item) {
+					return
+				}
+			}
+			at = start + len(chunk)
+		}
+	}
+}
+
+func (l List[T]) ForEach(consumer function.Consumer[T]) {
+	for item := range l.Iter() {
+		consumer(item)
+	}
+}
+
+func (l List[T]) ToList() collection.List[T] {
+	result := make(collection.List[T], 0, l.Len())
+	for item := range l.Iter() {
+		result = append(result, item)
+	}
+	return result
+}
+
+func (l List[T]) AsSequence() sequence.Seq[T] {
+	return sequence.FromIter(l.Iter())
+}
+
+func (l List[T]) String() string {
+	var str strings.Builder
+	str.WriteString("[")
+	first := true
+	for item := range l.Iter() {
+		if !first {
+			str.WriteString(", ")
+		}
+		str.WriteString(fmt.Sprintf("%v", item))
+		first = false
+	}
+	str.WriteString("]")
+	return str.String()
+}
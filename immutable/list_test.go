@@ -0,0 +1,149 @@
+package immutable_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/immutable"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestListOf(t *testing.T) {
+	t.Run("creates a list from items", func(t *testing.T) {
+		l := immutable.Of(1, 2, 3)
+		assert.Equal(t, 3, l.Len())
+		assert.Equal(t, "[1, 2, 3]", l.String())
+	})
+
+	t.Run("zero value is empty", func(t *testing.T) {
+		var l immutable.List[int]
+		assert.True(t, l.IsEmpty())
+		assert.True(t, l.First().IsEmpty())
+		assert.Equal(t, "[]", l.String())
+		assert.Equal(t, 1, l.Append(1).Len())
+	})
+
+	t.Run("copies the given slice", func(t *testing.T) {
+		items := []int{1, 2}
+		l := immutable.From(items)
+		items[0] = 9
+		assert.Equal(t, 1, l.Get(0))
+	})
+}
+
+func TestListAppend(t *testing.T) {
+	for _, n := range []int{1, 31, 32, 33, 1024, 1056, 1057, 40000} {
+		var l immutable.List[int]
+		for i := 0; i < n; i++ {
+			l = l.Append(i)
+		}
+		assert.Equal(t, n, l.Len())
+		assert.Equal(t, rangeOf(n), l.ToList())
+		assert.Equal(t, l.ToList(), immutable.From(rangeOf(n)).ToList())
+		assert.Equal(t, n-1, l.Last().GetValue())
+	}
+}
+
+func TestListPersistence(t *testing.T) {
+	t.Run("old versions are unchanged by Append", func(t *testing.T) {
+		base := immutable.From(rangeOf(64))
+		left := base.Append(-1)
+		right := base.Append(-2)
+
+		assert.Equal(t, 64, base.Len())
+		assert.Equal(t, -1, left.Get(64))
+		assert.Equal(t, -2, right.Get(64))
+	})
+
+	t.Run("old versions are unchanged by Set", func(t *testing.T) {
+		base := immutable.From(rangeOf(2000))
+		updated := base.Set(5, -5).Set(1999, -1)
+
+		assert.Equal(t, 5, base.Get(5))
+		assert.Equal(t, 1999, base.Get(1999))
+		assert.Equal(t, -5, updated.Get(5))
+		assert.Equal(t, -1, updated.Get(1999))
+	})
+
+	t.Run("appending to a slice leaves the original intact", func(t *testing.T) {
+		base := immutable.From(rangeOf(100))
+		head := base.Slice(0, 40).Append(-1)
+
+		assert.Equal(t, 40, base.Get(40))
+		assert.Equal(t, -1, head.Get(40))
+		assert.Equal(t, 41, head.Len())
+	})
+}
+
+func TestListSlice(t *testing.T) {
+	const n = 1100
+	l := immutable.From(rangeOf(n))
+	for _, bounds := range [][2]int{{0, n}, {0, 0}, {0, 1}, {0, 32}, {0, 33}, {0, 1024}, {0, 1025}, {5, 37}, {31, 1090}, {1050, 1100}, {1099, 1100}} {
+		start, end := bounds[0], bounds[1]
+		sliced := l.Slice(start, end)
+		assert.Equal(t, collection.List[int](rangeOf(n)[start:end]), sliced.ToList())
+	}
+
+	t.Run("slices of slices and updates use relative indexes", func(t *testing.T) {
+		sliced := l.Slice(10, 500).Slice(20, 100).Set(0, -1).Append(-2)
+
+		assert.Equal(t, 81, sliced.Len())
+		assert.Equal(t, -1, sliced.Get(0))
+		assert.Equal(t, 31, sliced.Get(1))
+		assert.Equal(t, -2, sliced.Get(80))
+	})
+
+	t.Run("keeps growing after shrinking a deep trie", func(t *testing.T) {
+		sliced := immutable.From(rangeOf(40000)).Slice(0, 1000)
+		for i := 1000; i < 2000; i++ {
+			sliced = sliced.Append(i)
+		}
+		assert.Equal(t, collection.List[int](rangeOf(2000)), sliced.ToList())
+	})
+
+	t.Run("panics on invalid bounds", func(t *testing.T) {
+		assert.Panics(t, func() { l.Slice(-1, 2) })
+		assert.Panics(t, func() { l.Slice(3, 2) })
+		assert.Panics(t, func() { l.Slice(0, n+1) })
+	})
+}
+
+func TestListGet(t *testing.T) {
+	l := immutable.Of("a", "b")
+	assert.Equal(t, "b", l.Get(1))
+	assert.Equal(t, "a", l.First().GetValue())
+	assert.True(t, l.ElementAt(2).IsEmpty())
+	assert.Panics(t, func() { l.Get(2) })
+	assert.Panics(t, func() { l.Set(-1, "z") })
+}
+
+func TestListPlus(t *testing.T) {
+	l := immutable.Of(1, 2).Plus(3).PlusAll(immutable.Of(4, 5))
+	assert.Equal(t, collection.List[int]{1, 2, 3, 4, 5}, l.ToList())
+}
+
+func TestListIteration(t *testing.T) {
+	l := immutable.From(rangeOf(100)).Slice(30, 70)
+
+	sum := 0
+	l.ForEach(func(n int) { sum += n })
+	assert.Equal(t, 1980, sum)
+
+	var firstThree []int
+	for n := range l.Iter() {
+		if len(firstThree) == 3 {
+			break
+		}
+		firstThree = append(firstThree, n)
+	}
+	assert.Equal(t, []int{30, 31, 32}, firstThree)
+	assert.Equal(t, 40, l.AsSequence().Count())
+}
+
+func rangeOf(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	return items
+}
@@ -0,0 +1,376 @@
+package immutable
+
+import (
+	"hash/maphash"
+	"iter"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/internal/hashing"
+	"github.com/marlonbarreto-git/gollections/tomove/function"
+	"github.com/marlonbarreto-git/gollections/tomove/optional"
+)
+
+const (
+	mapBits  = 5
+	mapMask  = 1<<mapBits - 1
+	hashSize = 64
+)
+
+var (
+	mapSeed = maphash.MakeSeed()
+	// hashMask is narrowed by tests to force hash collisions
+	hashMask uint64 = math.MaxUint64
+)
+
+// Map is a persistent hash map implemented as a hash array mapped trie (HAMT).
+// Put and Remove return a new map in O(log32 n), sharing everything but the changed path with the original,
+// so a Map can be handed to other goroutines as a snapshot without copying. Use a MapBuilder for bulk loads.
+// The zero value is an empty map.
+type Map[K comparable, V any] struct {
+	root *mapNode[K, V]
+	size int
+}
+
+// mapNode holds its entries packed by bitmap; below the last hash level it holds colliding keys in a plain list.
+// owner marks nodes a MapBuilder may still edit in place.
+type mapNode[K comparable, V any] struct {
+	bitmap  uint32
+	entries []mapEntry[K, V]
+	owner   *mapOwner
+}
+
+// mapEntry is either a key-value pair or, when child is set, a pointer to the next level
+type mapEntry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	child *mapNode[K, V]
+}
+
+type mapOwner struct {
+	_ byte
+}
+
+func EmptyMap[K comparable, V any]() Map[K, V] {
+	return Map[K, V]{}
+}
+
+func MapOf[K comparable, V any](pairs ...collection.Pair[K, V]) Map[K, V] {
+	b := NewMapBuilder[K, V]()
+	for _, pair := range pairs {
+		b.Put(pair.First(), pair.Second())
+	}
+	return b.Build()
+}
+
+func FromMutableMap[K comparable, V any](m collection.MutableMap[K, V]) Map[K, V] {
+	b := NewMapBuilder[K, V]()
+	for k, v := range m {
+		b.Put(k, v)
+	}
+	return b.Build()
+}
+
+func (m Map[K, V]) Len() int {
+	return m.size
+}
+
+func (m Map[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+func (m Map[K, V]) Get(key K) optional.Optional[V] {
+	if value, ok := m.Lookup(key); ok {
+		return optional.Of(value)
+	}
+	return optional.Empty[V]()
+}
+
+// Lookup returns the value for the key and whether it was present
+func (m Map[K, V]) Lookup(key K) (V, bool) {
+	return m.root.get(hashOf(key), 0, key)
+}
+
+func (m Map[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := m.Lookup(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (m Map[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Lookup(key)
+	return ok
+}
+
+// Put returns a new map with the key set to the value
+func (m Map[K, V]) Put(key K, value V) Map[K, V] {
+	root, added := m.root.put(nil, hashOf(key), 0, key, value)
+	m.root = root
+	if added {
+		m.size++
+	}
+	return m
+}
+
+func (m Map[K, V]) PutAll(pairs ...collection.Pair[K, V]) Map[K, V] {
+	b := m.Builder()
+	for _, pair := range pairs {
+		b.Put(pair.First(), pair.Second())
+	}
+	return b.Build()
+}
+
+// Remove returns a new map without the key, or the same map if the key is absent
+func (m Map[K, V]) Remove(key K) Map[K, V] {
+	root, removed := m.root.remove(nil, hashOf(key), 0, key)
+	if removed {
+		m.root = root
+		m.size--
+	}
+	return m
+}
+
+// Builder returns a MapBuilder starting from this map's entries. The map itself is never modified.
+func (m Map[K, V]) Builder() *MapBuilder[K, V] {
+	return &MapBuilder[K, V]{root: m.root, size: m.size, owner: new(mapOwner)}
+}
+
+func (m Map[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.each(yield)
+	}
+}
+
+func (m Map[K, V]) Keys() collection.List[K] {
+	keys := make(collection.List[K], 0, m.size)
+	for k := range m.Iter() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m Map[K, V]) Values() collection.List[V] {
+	values := make(collection.List[V], 0, m.size)
+	for _, v := range m.Iter() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (m Map[K, V]) Entries() []collection.Pair[K, V] {
+	entries := make([]collection.Pair[K, V], 0, m.size)
+	for k, v := range m.Iter() {
+		entries = append(entries, collection.PairOf(k, v))
+	}
+	return entries
+}
+
+func (m Map[K, V]) ForEach(consumer function.BiConsumer[K, V]) {
+	for k, v := range m.Iter() {
+		consumer(k, v)
+	}
+}
+
+func (m Map[K, V]) Filter(predicate function.BiPredicate[K, V]) Map[K, V] {
+	b := m.Builder()
+	for k, v := range m.Iter() {
+		if !predicate(k, v) {
+			b.Remove(k)
+		}
+	}
+	return b.Build()
+}
+
+func (m Map[K, V]) ToMutableMap() collection.MutableMap[K, V] {
+	result := make(collection.MutableMap[K, V], m.size)
+	for k, v := range m.Iter() {
+		result[k] = v
+	}
+	return result
+}
+
+func (m Map[K, V]) String() string {
+	return m.ToMutableMap().String()
+}
+
+func hashOf[K comparable](key K) uint64 {
+	return hashing.Comparable(mapSeed, key) & hashMask
+}
+
+func bitFor(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & mapMask)
+}
+
+func (n *mapNode[K, V]) index(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// editable returns the node itself if owner may edit it in place, or a copy owned by owner otherwise
+func (n *mapNode[K, V]) editable(owner *mapOwner) *mapNode[K, V] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+	entries := make([]mapEntry[K, V], len(n.entries), len(n.entries)+1)
+	copy(entries, n.entries)
+	return &mapNode[K, V]{bitmap: n.bitmap, entries: entries, owner: owner}
+}
+
+func (n *mapNode[K, V]) get(hash uint64, shift uint, key K) (value V, ok bool) {
+	for n != nil {
+		if shift >= hashSize {
+			for _, e := range n.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+			return
+		}
+
+		bit := bitFor(hash, shift)
+		if n.bitmap&bit == 0 {
+			return
+		}
+		e := n.entries[n.index(bit)]
+		if e.child == nil {
+			if e.key == key {
+				return e.value, true
+			}
+			return
+		}
+		n, shift = e.child, shift+mapBits
+	}
+	return
+}
+
+func (n *mapNode[K, V]) put(owner *mapOwner, hash uint64, shift uint, key K, value V) (*mapNode[K, V], bool) {
+	if n == nil {
+		n = &mapNode[K, V]{owner: owner}
+	}
+
+	if shift >= hashSize {
+		n = n.editable(owner)
+		for i := range n.entries {
+			if n.entries[i].key == key {
+				n.entries[i].value = value
+				return n, false
+			}
+		}
+		n.entries = append(n.entries, mapEntry[K, V]{hash: hash, key: key, value: value})
+		return n, true
+	}
+
+	bit := bitFor(hash, shift)
+	idx := n.index(bit)
+	if n.bitmap&bit == 0 {
+		n = n.editable(owner)
+		n.bitmap |= bit
+		n.entries = slices.Insert(n.entries, idx, mapEntry[K, V]{hash: hash, key: key, value: value})
+		return n, true
+	}
+
+	e := n.entries[idx]
+	switch {
+	case e.child != nil:
+		child, added := e.child.put(owner, hash, shift+mapBits, key, value)
+		n = n.editable(owner)
+		n.entries[idx].child = child
+		return n, added
+	case e.key == key:
+		n = n.editable(owner)
+		n.entries[idx].value = value
+		return n, false
+	default:
+		child := newMapPair(owner, shift+mapBits, e, mapEntry[K, V]{hash: hash, key: key, value: value})
+		n = n.editable(owner)
+		n.entries[idx] = mapEntry[K, V]{child: child}
+		return n, true
+	}
+}
+
+// newMapPair creates the node holding two entries whose hashes agree up to shift
+func newMapPair[K comparable, V any](owner *mapOwner, shift uint, a, b mapEntry[K, V]) *mapNode[K, V] {
+	if shift >= hashSize {
+		return &mapNode[K, V]{entries: []mapEntry[K, V]{a, b}, owner: owner}
+	}
+
+	bitA, bitB := bitFor(a.hash, shift), bitFor(b.hash, shift)
+	switch {
+	case bitA == bitB:
+		child := newMapPair(owner, shift+mapBits, a, b)
+		return &mapNode[K, V]{bitmap: bitA, entries: []mapEntry[K, V]{{child: child}}, owner: owner}
+	case bitA < bitB:
+		return &mapNode[K, V]{bitmap: bitA | bitB, entries: []mapEntry[K, V]{a, b}, owner: owner}
+	default:
+		return &mapNode[K, V]{bitmap: bitA | bitB, entries: []mapEntry[K, V]{b, a}, owner: owner}
+	}
+}
+
+func (n *mapNode[K, V]) remove(owner *mapOwner, hash uint64, shift uint, key K) (*mapNode[K, V], bool) {
+	if n == nil {
+		return n, false
+	}
+
+	if shift >= hashSize {
+		for i := range n.entries {
+			if n.entries[i].key == key {
+				n = n.editable(owner)
+				n.entries = slices.Delete(n.entries, i, i+1)
+				return n, true
+			}
+		}
+		return n, false
+	}
+
+	bit := bitFor(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	idx := n.index(bit)
+	e := n.entries[idx]
+
+	if e.child == nil {
+		if e.key != key {
+			return n, false
+		}
+		n = n.editable(owner)
+		n.bitmap &^= bit
+		n.entries = slices.Delete(n.entries, idx, idx+1)
+		return n, true
+	}
+
+	child, removed := e.child.remove(owner, hash, shift+mapBits, key)
+	if !removed {
+		return n, false
+	}
+	n = n.editable(owner)
+	switch {
+	case len(child.entries) == 0:
+		n.bitmap &^= bit
+		n.entries = slices.Delete(n.entries, idx, idx+1)
+	case len(child.entries) == 1 && child.entries[0].child == nil:
+		n.entries[idx] = child.entries[0]
+	default:
+		n.entries[idx].child = child
+	}
+	return n, true
+}
+
+func (n *mapNode[K, V]) each(yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	for _, e := range n.entries {
+		if e.child != nil {
+			if !e.child.each(yield) {
+				return false
+			}
+		} else if !yield(e.key, e.value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package immutable
+
+// MapBuilder is a transient, mutable version of a Map used to apply many changes cheaply:
+// nodes it has already copied are edited in place instead of being copied again on every update.
+// Build returns the result as a Map in O(1); later changes to the builder never affect maps it has built.
+// A MapBuilder is not safe for concurrent use.
+type MapBuilder[K comparable, V any] struct {
+	root  *mapNode[K, V]
+	size  int
+	owner *mapOwner
+}
+
+func NewMapBuilder[K comparable, V any]() *MapBuilder[K, V] {
+	return Map[K, V]{}.Builder()
+}
+
+func (b *MapBuilder[K, V]) Put(key K, value V) *MapBuilder[K, V] {
+	root, added := b.root.put(b.owner, hashOf(key), 0, key, value)
+	b.root = root
+	if added {
+		b.size++
+	}
+	return b
+}
+
+func (b *MapBuilder[K, V]) Remove(key K) *MapBuilder[K, V] {
+	root, removed := b.root.remove(b.owner, hashOf(key), 0, key)
+	if removed {
+		b.root = root
+		b.size--
+	}
+	return b
+}
+
+// Lookup returns the value for the key and whether it was present
+func (b *MapBuilder[K, V]) Lookup(key K) (V, bool) {
+	return b.root.get(hashOf(key), 0, key)
+}
+
+func (b *MapBuilder[K, V]) Len() int {
+	return b.size
+}
+
+// Build returns the current entries as a Map. The builder stays usable and copies nodes again before editing them.
+func (b *MapBuilder[K, V]) Build() Map[K, V] {
+	b.owner = new(mapOwner)
+	return Map[K, V]{root: b.root, size: b.size}
+}
@@ -0,0 +1,48 @@
+package immutable_test
+
+import (
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/immutable"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestMapBuilder(t *testing.T) {
+	t.Run("builds a map from bulk updates", func(t *testing.T) {
+		b := immutable.NewMapBuilder[int, int]()
+		for i := 0; i < 1000; i++ {
+			b.Put(i, i*i)
+		}
+		b.Remove(0).Remove(5000)
+
+		m := b.Build()
+		assert.Equal(t, 999, m.Len())
+		assert.Equal(t, 81, m.Get(9).GetValue())
+		assert.False(t, m.ContainsKey(0))
+	})
+
+	t.Run("later changes do not affect built maps", func(t *testing.T) {
+		b := immutable.NewMapBuilder[string, int]().Put("a", 1)
+		first := b.Build()
+		b.Put("a", 2).Put("b", 3)
+		second := b.Build()
+		b.Remove("a")
+
+		assert.MapEqual(t, map[string]int{"a": 1}, first.ToMutableMap())
+		assert.MapEqual(t, map[string]int{"a": 2, "b": 3}, second.ToMutableMap())
+		value, ok := b.Lookup("b")
+		assert.Equal(t, 3, value)
+		assert.True(t, ok)
+		assert.Equal(t, 1, b.Len())
+	})
+
+	t.Run("never modifies the map it starts from", func(t *testing.T) {
+		defer immutable.SetHashMask(0b1)()
+		base := immutable.MapOf(collection.PairOf(1, 1), collection.PairOf(2, 2), collection.PairOf(3, 3))
+		changed := base.Builder().Put(1, 10).Remove(2).Put(4, 4).Build()
+
+		assert.MapEqual(t, map[int]int{1: 1, 2: 2, 3: 3}, base.ToMutableMap())
+		assert.MapEqual(t, map[int]int{1: 10, 3: 3, 4: 4}, changed.ToMutableMap())
+	})
+}
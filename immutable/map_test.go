@@ -0,0 +1,120 @@
+package immutable_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/collection"
+	"github.com/marlonbarreto-git/gollections/immutable"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestMapBasics(t *testing.T) {
+	t.Run("zero value is empty", func(t *testing.T) {
+		var m immutable.Map[string, int]
+		assert.True(t, m.IsEmpty())
+		assert.True(t, m.Get("a").IsEmpty())
+		assert.Equal(t, 0, m.Remove("a").Len())
+		assert.Equal(t, "{}", m.String())
+	})
+
+	t.Run("puts, gets and removes", func(t *testing.T) {
+		m := immutable.MapOf(collection.PairOf("a", 1), collection.PairOf("b", 0))
+
+		assert.Equal(t, 2, m.Len())
+		assert.Equal(t, 1, m.Get("a").GetValue())
+		value, ok := m.Lookup("b")
+		assert.Equal(t, 0, value)
+		assert.True(t, ok)
+		assert.Equal(t, 7, m.GetOrDefault("z", 7))
+		assert.True(t, m.ContainsKey("b"))
+		assert.Equal(t, 3, m.Put("c", 3).Len())
+		assert.Equal(t, 2, m.Put("a", 10).Len())
+		assert.Equal(t, 1, m.Remove("a").Len())
+		assert.Equal(t, `{"a":1,"b":0}`, m.String())
+	})
+}
+
+func TestMapPersistence(t *testing.T) {
+	base := immutable.MapOf(collection.PairOf("a", 1), collection.PairOf("b", 2))
+	updated := base.Put("a", 10).Put("c", 3)
+	removed := base.Remove("b")
+
+	assert.MapEqual(t, map[string]int{"a": 1, "b": 2}, base.ToMutableMap())
+	assert.MapEqual(t, map[string]int{"a": 10, "b": 2, "c": 3}, updated.ToMutableMap())
+	assert.MapEqual(t, map[string]int{"a": 1}, removed.ToMutableMap())
+}
+
+func TestMapMatchesBuiltinMap(t *testing.T) {
+	for name, mask := range map[string]uint64{"full hash": ^uint64(0), "partial collisions": 0b1011, "full collisions": 0} {
+		t.Run(name, func(t *testing.T) {
+			defer immutable.SetHashMask(mask)()
+			random := rand.New(rand.NewSource(1))
+			expected := map[int]int{}
+			var m immutable.Map[int, int]
+			var snapshots []immutable.Map[int, int]
+			var expectedSnapshots []map[int]int
+
+			for i := 0; i < 3000; i++ {
+				key := random.Intn(400)
+				if random.Intn(3) == 0 {
+					delete(expected, key)
+					m = m.Remove(key)
+				} else {
+					expected[key] = i
+					m = m.Put(key, i)
+				}
+				if i%500 == 0 {
+					snapshots = append(snapshots, m)
+					expectedSnapshots = append(expectedSnapshots, collection.MutableMap[int, int](expected).Copy())
+				}
+			}
+
+			assert.Equal(t, len(expected), m.Len())
+			assert.MapEqual(t, expected, m.ToMutableMap())
+			for key := 0; key < 400; key++ {
+				_, want := expected[key]
+				assert.Equal(t, want, m.ContainsKey(key))
+			}
+			for i, snapshot := range snapshots {
+				assert.MapEqual(t, expectedSnapshots[i], snapshot.ToMutableMap())
+			}
+		})
+	}
+}
+
+func TestMapConversions(t *testing.T) {
+	source := collection.MutableMap[string, int]{"a": 1, "b": 2, "c": 3}
+	m := immutable.FromMutableMap(source)
+	source["d"] = 4
+
+	assert.Equal(t, 3, m.Len())
+	assert.MapEqual(t, map[string]int{"a": 1, "b": 2, "c": 3}, m.ToMutableMap())
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, collection.List[string]{"a", "b", "c"}, keys)
+	assert.Equal(t, 3, len(m.Values()))
+	assert.Equal(t, 3, len(m.Entries()))
+}
+
+func TestMapIteration(t *testing.T) {
+	m := immutable.MapOf(collection.PairOf("a", 1), collection.PairOf("b", 2), collection.PairOf("c", 3))
+
+	sum := 0
+	m.ForEach(func(_ string, v int) { sum += v })
+	assert.Equal(t, 6, sum)
+
+	visited := 0
+	for range m.Iter() {
+		visited++
+		break
+	}
+	assert.Equal(t, 1, visited)
+
+	odd := m.Filter(func(_ string, v int) bool { return v%2 == 1 })
+	assert.MapEqual(t, map[string]int{"a": 1, "c": 3}, odd.ToMutableMap())
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, 4, m.PutAll(collection.PairOf("d", 4), collection.PairOf("a", 1)).Len())
+}
@@ -0,0 +1,71 @@
+// Package hashing hashes arbitrary comparable values for the hash-based collections
+package hashing
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// Comparable hashes any comparable value so that equal values always get equal hashes,
+// mirroring the equality rules of Go maps (e.g. +0 and -0 hash alike)
+func Comparable[K comparable](seed maphash.Seed, key K) uint64 {
+	if s, ok := any(key).(string); ok {
+		return maphash.String(seed, s)
+	}
+
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHash(&h, reflect.ValueOf(&key).Elem())
+	return h.Sum64()
+}
+
+func writeHash(h *maphash.Hash, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(h, real(v.Complex()))
+		writeFloat(h, imag(v.Complex()))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(h, uint64(v.Pointer()))
+	case reflect.Interface:
+		if !v.IsNil() {
+			writeHash(h, v.Elem())
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeHash(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeHash(h, v.Field(i))
+		}
+	}
+}
+
+func writeFloat(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
+
+func writeUint64(h *maphash.Hash, n uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], n)
+	h.Write(buf[:])
+}
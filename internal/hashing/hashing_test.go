@@ -0,0 +1,40 @@
+package hashing_test
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+
+	"github.com/marlonbarreto-git/gollections/internal/hashing"
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+)
+
+func TestComparable(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	t.Run("hashes equal values alike", func(t *testing.T) {
+		type point struct {
+			x, y  float64
+			label any
+		}
+		a, b := 7, 7
+		assert.Equal(t, hashing.Comparable(seed, "key"), hashing.Comparable(seed, "key"))
+		assert.Equal(t, hashing.Comparable(seed, 0.0), hashing.Comparable(seed, math.Copysign(0, -1)))
+		assert.Equal(t, hashing.Comparable(seed, point{1, 2, "p"}), hashing.Comparable(seed, point{1, 2, "p"}))
+		assert.Equal(t, hashing.Comparable(seed, [2]bool{true, false}), hashing.Comparable(seed, [2]bool{true, false}))
+		assert.Equal(t, hashing.Comparable[any](seed, nil), hashing.Comparable[any](seed, nil))
+		assert.NotEqual(t, hashing.Comparable(seed, &a), hashing.Comparable(seed, &b))
+	})
+
+	t.Run("spreads different values", func(t *testing.T) {
+		seen := map[uint64]bool{}
+		for i := 0; i < 1000; i++ {
+			seen[hashing.Comparable(seed, i)] = true
+		}
+		assert.Equal(t, 1000, len(seen))
+	})
+
+	t.Run("depends on the seed", func(t *testing.T) {
+		assert.NotEqual(t, hashing.Comparable(seed, 42), hashing.Comparable(maphash.MakeSeed(), 42))
+	})
+}
@@ -6,6 +6,8 @@ import (
 )
 
 // Api represents a map data structure.
+// Implementations backed by a plain map, such as collection.MutableMap, are not safe for concurrent use;
+// collection.ConcurrentMap is.
 type Api[K comparable, V any] interface {
 
 	// Map applies the specified function to each key-value pair in the map and returns the mutable map with the results.
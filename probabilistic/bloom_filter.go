@@ -0,0 +1,143 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+var (
+	IncompatibleError    = errors.New("incompatible structures")
+	InvalidEncodingError = errors.New("invalid encoding")
+)
+
+const bloomFilterMagic = "GBF1"
+
+// BloomFilter answers "have I seen this item?" in constant memory. MightContain never returns false for an
+// added item, but may return true for an item never added, with roughly the configured probability
+// as long as no more than the expected number of items are added. Items cannot be removed.
+type BloomFilter[T any] struct {
+	words  []uint64
+	size   uint64
+	hashes int
+	count  uint64
+	hasher Hasher[T]
+}
+
+// NewBloomFilter creates a filter sized to hold expectedItems with the given false positive rate.
+// It panics if expectedItems is not positive or the rate is not between 0 and 1.
+func NewBloomFilter[T any](expectedItems int, falsePositiveRate float64, hasher Hasher[T]) *BloomFilter[T] {
+	if expectedItems <= 0 {
+		panic(fmt.Sprintf("expected items must be positive: %d", expectedItems))
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic(fmt.Sprintf("false positive rate must be between 0 and 1: %v", falsePositiveRate))
+	}
+
+	n := float64(expectedItems)
+	size := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := max(1, int(math.Round(float64(size)/n*math.Ln2)))
+	return &BloomFilter[T]{words: make([]uint64, (size+63)/64), size: size, hashes: hashes, hasher: hasher}
+}
+
+func (f *BloomFilter[T]) Add(item T) {
+	indexes(f.hasher(item), f.hashes, f.size, func(_ int, index uint64) {
+		f.words[index/64] |= 1 << (index % 64)
+	})
+	f.count++
+}
+
+func (f *BloomFilter[T]) AddAll(items ...T) {
+	for _, item := range items {
+		f.Add(item)
+	}
+}
+
+// MightContain returns false if the item was definitely never added, and true if it probably was
+func (f *BloomFilter[T]) MightContain(item T) bool {
+	found := true
+	indexes(f.hasher(item), f.hashes, f.size, func(_ int, index uint64) {
+		found = found && f.words[index/64]&(1<<(index%64)) != 0
+	})
+	return found
+}
+
+// Count returns how many times Add was called, including duplicates and the counts of merged filters
+func (f *BloomFilter[T]) Count() uint64 {
+	return f.count
+}
+
+// Size returns the number of bits in the filter
+func (f *BloomFilter[T]) Size() uint64 {
+	return f.size
+}
+
+// Hashes returns the number of bits set per item
+func (f *BloomFilter[T]) Hashes() int {
+	return f.hashes
+}
+
+// EstimatedFalsePositiveRate estimates the current false positive probability from the share of bits set
+func (f *BloomFilter[T]) EstimatedFalsePositiveRate() float64 {
+	set := 0
+	for _, word := range f.words {
+		set += bits.OnesCount64(word)
+	}
+	return math.Pow(float64(set)/float64(f.size), float64(f.hashes))
+}
+
+// Merge adds every item of other into this filter. Both must have been created with the same
+// size and number of hashes, and the same Hasher, otherwise it fails with IncompatibleError.
+func (f *BloomFilter[T]) Merge(other *BloomFilter[T]) error {
+	if f.size != other.size || f.hashes != other.hashes {
+		return fmt.Errorf("%w: bloom filters of %d bits/%d hashes and %d bits/%d hashes",
+			IncompatibleError, f.size, f.hashes, other.size, other.hashes)
+	}
+	for i, word := range other.words {
+		f.words[i] |= word
+	}
+	f.count += other.count
+	return nil
+}
+
+// MarshalBinary encodes the filter's parameters and bits. The Hasher is not included.
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(bloomFilterMagic)+24+8*len(f.words))
+	data = append(data, bloomFilterMagic...)
+	data = binary.BigEndian.AppendUint64(data, f.size)
+	data = binary.BigEndian.AppendUint64(data, uint64(f.hashes))
+	data = binary.BigEndian.AppendUint64(data, f.count)
+	for _, word := range f.words {
+		data = binary.BigEndian.AppendUint64(data, word)
+	}
+	return data, nil
+}
+
+// UnmarshalBloomFilter decodes a filter written by MarshalBinary. hasher must be the Hasher the filter was built with.
+func UnmarshalBloomFilter[T any](data []byte, hasher Hasher[T]) (*BloomFilter[T], error) {
+	header := len(bloomFilterMagic) + 24
+	if len(data) < header || string(data[:len(bloomFilterMagic)]) != bloomFilterMagic {
+		return nil, fmt.Errorf("%w: not a bloom filter", InvalidEncodingError)
+	}
+
+	size := binary.BigEndian.Uint64(data[4:])
+	hashes := binary.BigEndian.Uint64(data[12:])
+	count := binary.BigEndian.Uint64(data[20:])
+	// counted without rounding size up, which would overflow for a corrupt size near 2^64
+	words := size / 64
+	if size%64 != 0 {
+		words++
+	}
+	payload := uint64(len(data) - header)
+	if size == 0 || hashes == 0 || hashes > math.MaxInt32 || payload%8 != 0 || payload/8 != words {
+		return nil, fmt.Errorf("%w: corrupt bloom filter", InvalidEncodingError)
+	}
+
+	f := &BloomFilter[T]{words: make([]uint64, words), size: size, hashes: int(hashes), count: count, hasher: hasher}
+	for i := range f.words {
+		f.words[i] = binary.BigEndian.Uint64(data[header+8*i:])
+	}
+	return f, nil
+}
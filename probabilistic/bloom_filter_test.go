@@ -0,0 +1,119 @@
+package probabilistic_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/probabilistic"
+)
+
+func TestBloomFilterMembership(t *testing.T) {
+	t.Run("never forgets an added item", func(t *testing.T) {
+		f := probabilistic.NewBloomFilter(1000, 0.01, probabilistic.StringHasher)
+		for i := 0; i < 1000; i++ {
+			f.Add("event-" + strconv.Itoa(i))
+		}
+		for i := 0; i < 1000; i++ {
+			assert.True(t, f.MightContain("event-"+strconv.Itoa(i)))
+		}
+		assert.Equal(t, uint64(1000), f.Count())
+	})
+
+	t.Run("keeps false positives near the configured rate", func(t *testing.T) {
+		f := probabilistic.NewBloomFilter(10000, 0.01, probabilistic.IntHasher[int])
+		for i := 0; i < 10000; i++ {
+			f.Add(i)
+		}
+
+		falsePositives := 0
+		for i := 10000; i < 110000; i++ {
+			if f.MightContain(i) {
+				falsePositives++
+			}
+		}
+		assert.Less(t, float64(falsePositives)/100000, 0.02)
+		assert.Less(t, f.EstimatedFalsePositiveRate(), 0.02)
+	})
+
+	t.Run("sizes itself from the expected items and rate", func(t *testing.T) {
+		f := probabilistic.NewBloomFilter(1000, 0.01, probabilistic.StringHasher)
+		assert.Equal(t, uint64(9586), f.Size())
+		assert.Equal(t, 7, f.Hashes())
+		assert.Equal(t, 0.0, f.EstimatedFalsePositiveRate())
+	})
+
+	t.Run("works with any comparable type", func(t *testing.T) {
+		type key struct{ tenant, id int }
+		f := probabilistic.NewBloomFilter(100, 0.01, probabilistic.ComparableHasher[key]())
+		f.AddAll(key{1, 1}, key{1, 2})
+		assert.True(t, f.MightContain(key{1, 2}))
+		assert.False(t, f.MightContain(key{2, 1}))
+	})
+
+	t.Run("panics on invalid parameters", func(t *testing.T) {
+		assert.Panics(t, func() { probabilistic.NewBloomFilter(0, 0.01, probabilistic.StringHasher) })
+		assert.Panics(t, func() { probabilistic.NewBloomFilter(10, 0, probabilistic.StringHasher) })
+		assert.Panics(t, func() { probabilistic.NewBloomFilter(10, 1, probabilistic.StringHasher) })
+	})
+}
+
+func TestBloomFilterMerge(t *testing.T) {
+	left := probabilistic.NewBloomFilter(100, 0.01, probabilistic.StringHasher)
+	right := probabilistic.NewBloomFilter(100, 0.01, probabilistic.StringHasher)
+	left.Add("a")
+	right.Add("b")
+
+	assert.NoError(t, left.Merge(right))
+	assert.True(t, left.MightContain("a"))
+	assert.True(t, left.MightContain("b"))
+	assert.False(t, right.MightContain("a"))
+	assert.Equal(t, uint64(2), left.Count())
+
+	other := probabilistic.NewBloomFilter(100, 0.1, probabilistic.StringHasher)
+	assert.True(t, errors.Is(left.Merge(other), probabilistic.IncompatibleError))
+
+	t.Run("filters from separate comparable hashers", func(t *testing.T) {
+		evens := probabilistic.NewBloomFilter(1000, 0.01, probabilistic.ComparableHasher[int]())
+		odds := probabilistic.NewBloomFilter(1000, 0.01, probabilistic.ComparableHasher[int]())
+		for i := range 500 {
+			evens.Add(2 * i)
+			odds.Add(2*i + 1)
+		}
+		assert.NoError(t, evens.Merge(odds))
+		for i := range 1000 {
+			assert.True(t, evens.MightContain(i))
+		}
+	})
+}
+
+func TestBloomFilterSerialization(t *testing.T) {
+	f := probabilistic.NewBloomFilter(500, 0.05, probabilistic.StringHasher)
+	f.AddAll("a", "b", "c")
+
+	data, err := f.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded, err := probabilistic.UnmarshalBloomFilter(data, probabilistic.StringHasher)
+	assert.NoError(t, err)
+	assert.True(t, decoded.MightContain("b"))
+	assert.False(t, decoded.MightContain("z"))
+	assert.Equal(t, f.Size(), decoded.Size())
+	assert.Equal(t, f.Hashes(), decoded.Hashes())
+	assert.Equal(t, uint64(3), decoded.Count())
+
+	t.Run("rejects invalid data", func(t *testing.T) {
+		// a size near 2^64 must not wrap around to an empty bit array
+		hugeSize := append([]byte("GBF1"), binary.BigEndian.AppendUint64(nil, math.MaxUint64)...)
+		hugeSize = binary.BigEndian.AppendUint64(hugeSize, 3)
+		hugeSize = binary.BigEndian.AppendUint64(hugeSize, 0)
+
+		for _, bad := range [][]byte{nil, []byte("nope"), data[:len(data)-1], append([]byte("XXXX"), data[4:]...), hugeSize} {
+			_, err := probabilistic.UnmarshalBloomFilter(bad, probabilistic.StringHasher)
+			assert.True(t, errors.Is(err, probabilistic.InvalidEncodingError))
+		}
+	})
+}
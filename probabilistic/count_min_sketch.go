@@ -0,0 +1,69 @@
+package probabilistic
+
+import (
+	"fmt"
+	"math"
+)
+
+// CountMinSketch estimates how often each item occurred in a stream, in memory independent of the number of items.
+// Estimate never undercounts; with probability 1-delta it overcounts by at most epsilon times the total count.
+type CountMinSketch[T any] struct {
+	rows   [][]uint64
+	width  uint64
+	total  uint64
+	hasher Hasher[T]
+}
+
+// NewCountMinSketch creates a sketch with error bound epsilon and failure probability delta.
+// It panics if either is not between 0 and 1.
+func NewCountMinSketch[T any](epsilon, delta float64, hasher Hasher[T]) *CountMinSketch[T] {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 {
+		panic(fmt.Sprintf("epsilon and delta must be between 0 and 1: %v, %v", epsilon, delta))
+	}
+
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	rows := make([][]uint64, depth)
+	for i := range rows {
+		rows[i] = make([]uint64, width)
+	}
+	return &CountMinSketch[T]{rows: rows, width: width, hasher: hasher}
+}
+
+// Add records n more occurrences of the item
+func (s *CountMinSketch[T]) Add(item T, n uint64) {
+	indexes(s.hasher(item), len(s.rows), s.width, func(row int, index uint64) {
+		s.rows[row][index] += n
+	})
+	s.total += n
+}
+
+// Estimate returns the approximate number of occurrences of the item, never less than the real one
+func (s *CountMinSketch[T]) Estimate(item T) uint64 {
+	estimate := uint64(math.MaxUint64)
+	indexes(s.hasher(item), len(s.rows), s.width, func(row int, index uint64) {
+		estimate = min(estimate, s.rows[row][index])
+	})
+	return estimate
+}
+
+// Total returns the number of occurrences added
+func (s *CountMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+// Merge adds the counts of other into this sketch. Both must have the same dimensions and Hasher,
+// otherwise it fails with IncompatibleError.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if s.width != other.width || len(s.rows) != len(other.rows) {
+		return fmt.Errorf("%w: count-min sketches of %dx%d and %dx%d",
+			IncompatibleError, len(s.rows), s.width, len(other.rows), other.width)
+	}
+	for i, row := range other.rows {
+		for j, count := range row {
+			s.rows[i][j] += count
+		}
+	}
+	s.total += other.total
+	return nil
+}
@@ -0,0 +1,49 @@
+package probabilistic_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/probabilistic"
+)
+
+func TestCountMinSketchEstimates(t *testing.T) {
+	s := probabilistic.NewCountMinSketch(0.001, 0.01, probabilistic.StringHasher)
+	for i := 0; i < 1000; i++ {
+		s.Add("item-"+strconv.Itoa(i), 1)
+	}
+	s.Add("hot", 500)
+	s.Add("hot", 250)
+
+	assert.Equal(t, uint64(1750), s.Total())
+	assert.GreaterOrEqual(t, s.Estimate("hot"), uint64(750))
+	assert.LessOrEqual(t, s.Estimate("hot"), uint64(750+2))
+	for i := 0; i < 1000; i++ {
+		estimate := s.Estimate("item-" + strconv.Itoa(i))
+		assert.GreaterOrEqual(t, estimate, uint64(1))
+		assert.LessOrEqual(t, estimate, uint64(1+2))
+	}
+	assert.LessOrEqual(t, s.Estimate("never added"), uint64(2))
+}
+
+func TestCountMinSketchMerge(t *testing.T) {
+	left := probabilistic.NewCountMinSketch(0.01, 0.01, probabilistic.IntHasher[int])
+	right := probabilistic.NewCountMinSketch(0.01, 0.01, probabilistic.IntHasher[int])
+	left.Add(1, 3)
+	right.Add(1, 4)
+	right.Add(2, 1)
+
+	assert.NoError(t, left.Merge(right))
+	assert.Equal(t, uint64(7), left.Estimate(1))
+	assert.Equal(t, uint64(8), left.Total())
+
+	other := probabilistic.NewCountMinSketch(0.1, 0.01, probabilistic.IntHasher[int])
+	assert.True(t, errors.Is(left.Merge(other), probabilistic.IncompatibleError))
+}
+
+func TestNewCountMinSketchPanicsOnInvalidParameters(t *testing.T) {
+	assert.Panics(t, func() { probabilistic.NewCountMinSketch(0, 0.01, probabilistic.StringHasher) })
+	assert.Panics(t, func() { probabilistic.NewCountMinSketch(0.01, 1, probabilistic.StringHasher) })
+}
@@ -0,0 +1,63 @@
+// Package probabilistic provides compact structures that answer questions about a stream of items
+// approximately, using a fixed amount of memory however many items they see.
+package probabilistic
+
+import (
+	"hash/fnv"
+	"hash/maphash"
+
+	"github.com/marlonbarreto-git/gollections/internal/hashing"
+)
+
+// Hasher maps an item to a 64-bit hash. Equal items must get equal hashes, and the hashes should be well spread.
+// Structures only give the same answers after serialization if they are read back with the same Hasher.
+type Hasher[T any] func(T) uint64
+
+// StringHasher hashes strings with FNV-1a. It is deterministic, so filters using it can be serialized and shared.
+func StringHasher(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return mix(h.Sum64())
+}
+
+// BytesHasher hashes byte slices with FNV-1a. It is deterministic, so filters using it can be serialized and shared.
+func BytesHasher(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return mix(h.Sum64())
+}
+
+// IntHasher hashes integers deterministically, so filters using it can be serialized and shared
+func IntHasher[T ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](n T) uint64 {
+	return mix(uint64(n))
+}
+
+// comparableSeed is shared by every ComparableHasher, so filters built with separate calls can be merged
+var comparableSeed = maphash.MakeSeed()
+
+// ComparableHasher returns a Hasher for any comparable type. Its hashes are randomly seeded per process,
+// so structures using it must not be serialized and read back by another process.
+func ComparableHasher[T comparable]() Hasher[T] {
+	return func(item T) uint64 {
+		return hashing.Comparable(comparableSeed, item)
+	}
+}
+
+// mix is the splitmix64 finalizer, spreading every input bit over the whole hash
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// indexes derives the k positions of an item in a table of the given size from a single hash,
+// using the Kirsch-Mitzenmacher double hashing scheme
+func indexes(hash uint64, k int, size uint64, fn func(i int, index uint64)) {
+	h1, h2 := hash, mix(hash)|1
+	for i := 0; i < k; i++ {
+		fn(i, (h1+uint64(i)*h2)%size)
+	}
+}
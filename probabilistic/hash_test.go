@@ -0,0 +1,28 @@
+package probabilistic_test
+
+import (
+	"testing"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/probabilistic"
+)
+
+func TestHashers(t *testing.T) {
+	t.Run("deterministic hashers are stable", func(t *testing.T) {
+		assert.Equal(t, probabilistic.StringHasher("event"), probabilistic.BytesHasher([]byte("event")))
+		assert.NotEqual(t, probabilistic.StringHasher("event"), probabilistic.StringHasher("events"))
+		assert.Equal(t, probabilistic.IntHasher(42), probabilistic.IntHasher(int64(42)))
+		assert.NotEqual(t, probabilistic.IntHasher(1), probabilistic.IntHasher(2))
+	})
+
+	t.Run("comparable hasher hashes equal values alike", func(t *testing.T) {
+		hash := probabilistic.ComparableHasher[[2]string]()
+		assert.Equal(t, hash([2]string{"a", "b"}), hash([2]string{"a", "b"}))
+		assert.NotEqual(t, hash([2]string{"a", "b"}), hash([2]string{"b", "a"}))
+	})
+
+	t.Run("comparable hashers agree within the process", func(t *testing.T) {
+		first, second := probabilistic.ComparableHasher[int](), probabilistic.ComparableHasher[int]()
+		assert.Equal(t, first(42), second(42))
+	})
+}
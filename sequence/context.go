@@ -0,0 +1,55 @@
+package sequence
+
+import (
+	"context"
+)
+
+// WithContext ends the sequence once ctx is done, without pulling another item from s.
+// A source blocked inside a single pull, like a Generate supplier waiting on I/O, is not interrupted.
+func WithContext[T any](ctx context.Context, s Seq[T]) Seq[T] {
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			if ctx.Err() != nil {
+				return
+			}
+			for item := range s.iter {
+				if ctx.Err() != nil || !yield(item) || ctx.Err() != nil {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ForEachCtx calls fn on each item until the sequence ends or ctx is done, returning ctx.Err() in the latter case
+func (s Seq[T]) ForEachCtx(ctx context.Context, fn func(T)) error {
+	_, err := FoldCtx(ctx, s, struct{}{}, func(acc struct{}, item T) struct{} {
+		fn(item)
+		return acc
+	})
+	return err
+}
+
+// ToSliceCtx collects the items until the sequence ends or ctx is done. On cancellation it returns the
+// items collected so far along with ctx.Err().
+func (s Seq[T]) ToSliceCtx(ctx context.Context) ([]T, error) {
+	return FoldCtx(ctx, s, []T{}, func(acc []T, item T) []T {
+		return append(acc, item)
+	})
+}
+
+// FoldCtx folds the items until the sequence ends or ctx is done. On cancellation it returns the
+// accumulator so far along with ctx.Err().
+func FoldCtx[T, R any](ctx context.Context, s Seq[T], initial R, fn func(R, T) R) (R, error) {
+	acc := initial
+	if err := ctx.Err(); err != nil {
+		return acc, err
+	}
+	for item := range s.iter {
+		acc = fn(acc, item)
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+	}
+	return acc, nil
+}
@@ -0,0 +1,115 @@
+package sequence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/sequence"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Run("stops pulling once cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		pulls := 0
+		source := sequence.Generate(func() int {
+			pulls++
+			if pulls == 3 {
+				cancel()
+			}
+			return pulls
+		})
+		assert.Equal(t, []int{1, 2}, sequence.WithContext(ctx, source).ToSlice())
+		assert.Equal(t, 3, pulls)
+	})
+
+	t.Run("a done context yields nothing", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		pulls := 0
+		source := sequence.Of(1, 2, 3).OnEach(func(int) { pulls++ })
+		assert.Equal(t, 0, sequence.WithContext(ctx, source).Count())
+		assert.Equal(t, 0, pulls)
+	})
+
+	t.Run("passes everything through while live", func(t *testing.T) {
+		seq := sequence.WithContext(context.Background(), sequence.Range(0, 5, 1))
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, seq.ToSlice())
+	})
+
+	t.Run("cuts off a parallel source", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		ticks := sequence.Generate(func() int {
+			time.Sleep(time.Millisecond)
+			return 1
+		})
+		p := sequence.Parallel(sequence.WithContext(ctx, ticks), 2)
+		total := sequence.ParallelReduce(p, 0, func(acc, n int) int { return acc + n }, func(a, b int) int { return a + b })
+		assert.Greater(t, total, 0)
+		assert.True(t, errors.Is(ctx.Err(), context.DeadlineExceeded))
+	})
+}
+
+func TestForEachCtx(t *testing.T) {
+	t.Run("runs to the end", func(t *testing.T) {
+		sum := 0
+		err := sequence.Of(1, 2, 3).ForEachCtx(context.Background(), func(n int) { sum += n })
+		assert.NoError(t, err)
+		assert.Equal(t, 6, sum)
+	})
+
+	t.Run("returns the context error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var seen []int
+		err := sequence.Iterate(1, func(n int) int { return n + 1 }).ForEachCtx(ctx, func(n int) {
+			seen = append(seen, n)
+			if n == 4 {
+				cancel()
+			}
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []int{1, 2, 3, 4}, seen)
+	})
+}
+
+func TestToSliceCtx(t *testing.T) {
+	t.Run("collects everything", func(t *testing.T) {
+		items, err := sequence.Of("a", "b").ToSliceCtx(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, items)
+
+		items, err = sequence.Of[string]().ToSliceCtx(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, items)
+	})
+
+	t.Run("keeps the items collected before a timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		slow := sequence.Generate(func() int {
+			time.Sleep(time.Millisecond)
+			return 7
+		})
+		items, err := slow.ToSliceCtx(ctx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.NotEmpty(t, items)
+		assert.True(t, sequence.From(items).All(func(n int) bool { return n == 7 }))
+	})
+}
+
+func TestFoldCtx(t *testing.T) {
+	total, err := sequence.FoldCtx(context.Background(), sequence.Range(1, 5, 1), "", func(acc string, n int) string {
+		return acc + string(rune('0'+n))
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", total)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	partial, err := sequence.FoldCtx(ctx, sequence.Range(1, 5, 1), 100, func(acc, n int) int { return acc + n })
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 100, partial)
+}
@@ -0,0 +1,99 @@
+package sequence
+
+// Range yields start, start+step, start+2*step, ... up to end, excluded. A negative step counts down
+// to end instead. It stops early rather than overflow T. It panics if step is zero.
+func Range[T Numeric](start, end, step T) Seq[T] {
+	if step == 0 {
+		panic("step must not be zero")
+	}
+	ascending := step > 0
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			for value := start; (ascending && value < end) || (!ascending && value > end); {
+				if !yield(value) {
+					return
+				}
+				next := value + step
+				if (ascending && next <= value) || (!ascending && next >= value) {
+					return
+				}
+				value = next
+			}
+		},
+	}
+}
+
+// Iterate yields seed, next(seed), next(next(seed)), ... forever
+func Iterate[T any](seed T, next func(T) T) Seq[T] {
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			for value := seed; yield(value); value = next(value) {
+			}
+		},
+	}
+}
+
+// Generate yields the results of calling supplier, forever
+func Generate[T any](supplier func() T) Seq[T] {
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			for yield(supplier()) {
+			}
+		},
+	}
+}
+
+// Repeat yields the value n times
+func Repeat[T any](value T, n int) Seq[T] {
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			for range n {
+				if !yield(value) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Cycle yields the items of the sequence over and over, forever. The items are iterated once and
+// kept in memory for the following rounds. An empty sequence gives an empty cycle.
+func Cycle[T any](s Seq[T]) Seq[T] {
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			var items []T
+			for item := range s.iter {
+				items = append(items, item)
+				if !yield(item) {
+					return
+				}
+			}
+			if len(items) == 0 {
+				return
+			}
+			for {
+				for _, item := range items {
+					if !yield(item) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// Unfold builds a sequence from a state: fn returns the next item and the next state, or false to end the sequence
+func Unfold[S, T any](state S, fn func(S) (T, S, bool)) Seq[T] {
+	return Seq[T]{
+		iter: func(yield func(T) bool) {
+			current := state
+			for {
+				item, next, ok := fn(current)
+				if !ok || !yield(item) {
+					return
+				}
+				current = next
+			}
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package sequence_test
+
+import (
+	"math"
+	"testing"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/sequence"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("counts up to the end, excluded", func(t *testing.T) {
+		assert.Equal(t, []int{0, 3, 6, 9}, sequence.Range(0, 10, 3).ToSlice())
+		assert.Equal(t, []int{0, 1, 2}, sequence.Range(0, 3, 1).ToSlice())
+	})
+
+	t.Run("counts down with a negative step", func(t *testing.T) {
+		assert.Equal(t, []int{5, 3, 1}, sequence.Range(5, 0, -2).ToSlice())
+	})
+
+	t.Run("works with floats", func(t *testing.T) {
+		assert.Equal(t, []float64{0, 0.25, 0.5, 0.75}, sequence.Range(0, 1, 0.25).ToSlice())
+	})
+
+	t.Run("empty when the end is behind", func(t *testing.T) {
+		assert.Equal(t, []int{}, sequence.Range(5, 5, 1).ToSlice())
+		assert.Equal(t, []int{}, sequence.Range(5, 0, 1).ToSlice())
+	})
+
+	t.Run("stops before overflowing", func(t *testing.T) {
+		assert.Equal(t, []uint8{250, 253}, sequence.Range[uint8](250, 255, 3).ToSlice())
+		assert.Equal(t, []int8{120, 125}, sequence.Range[int8](120, math.MaxInt8, 5).ToSlice())
+	})
+
+	t.Run("panics on a zero step", func(t *testing.T) {
+		assert.Panics(t, func() { sequence.Range(0, 10, 0) })
+	})
+}
+
+func TestIterate(t *testing.T) {
+	powers := sequence.Iterate(1, func(x int) int { return x * 2 })
+	assert.Equal(t, []int{1, 2, 4, 8, 16}, powers.Take(5).ToSlice())
+
+	collatz := sequence.Iterate(6, func(x int) int {
+		if x%2 == 0 {
+			return x / 2
+		}
+		return 3*x + 1
+	}).TakeWhile(func(x int) bool { return x != 1 })
+	assert.Equal(t, []int{6, 3, 10, 5, 16, 8, 4, 2}, collatz.ToSlice())
+}
+
+func TestGenerate(t *testing.T) {
+	calls := 0
+	seq := sequence.Generate(func() int {
+		calls++
+		return calls * calls
+	})
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, []int{1, 4, 9}, seq.Take(3).ToSlice())
+	assert.Equal(t, 3, calls)
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, []string{"ha", "ha", "ha"}, sequence.Repeat("ha", 3).ToSlice())
+	assert.Equal(t, []string{}, sequence.Repeat("ha", 0).ToSlice())
+	assert.Equal(t, []string{}, sequence.Repeat("ha", -1).ToSlice())
+}
+
+func TestCycle(t *testing.T) {
+	pulls := 0
+	source := sequence.Of(1, 2, 3).OnEach(func(int) { pulls++ })
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 1}, sequence.Cycle(source).Take(7).ToSlice())
+	assert.Equal(t, 3, pulls)
+
+	assert.Equal(t, []int{}, sequence.Cycle(sequence.Of[int]()).ToSlice())
+}
+
+func TestUnfold(t *testing.T) {
+	fibonacci := sequence.Unfold([2]int{0, 1}, func(state [2]int) (int, [2]int, bool) {
+		return state[0], [2]int{state[1], state[0] + state[1]}, true
+	})
+	assert.Equal(t, []int{0, 1, 1, 2, 3, 5, 8, 13}, fibonacci.Take(8).ToSlice())
+
+	digits := sequence.Unfold(1234, func(n int) (int, int, bool) {
+		return n % 10, n / 10, n > 0
+	})
+	assert.Equal(t, []int{4, 3, 2, 1}, digits.ToSlice())
+}
+
+func TestFirstPrimes(t *testing.T) {
+	isPrime := func(n int) bool {
+		for d := 2; d*d <= n; d++ {
+			if n%d == 0 {
+				return false
+			}
+		}
+		return n > 1
+	}
+	primes := sequence.Iterate(2, func(n int) int { return n + 1 }).Filter(isPrime).Take(10)
+	assert.Equal(t, []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}, primes.ToSlice())
+}
@@ -0,0 +1,271 @@
+package sequence
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// ParallelSeq is a sequence whose stages run on a pool of worker goroutines. Stages added with ParallelMap and
+// ParallelFilter are fused, so each item goes through all of them on one worker. Nothing runs until the
+// sequence is iterated, and when the iteration ends, by a break or a panic included, every goroutine it started
+// has finished, so no stage or source code runs behind the caller's back.
+//
+// Results come in completion order unless Ordered is set. A panic in a stage is raised again in the goroutine
+// iterating the sequence. The source sequence is read from a single goroutine, which may pull one item more than
+// needed, and a source blocked inside a pull holds up the end of the iteration until it returns.
+type ParallelSeq[T any] struct {
+	tasks   iter.Seq[func() (T, bool)]
+	workers int
+	ordered bool
+}
+
+type parallelJob[T any] struct {
+	index int
+	task  func() (T, bool)
+}
+
+type parallelResult[T any] struct {
+	index    int
+	item     T
+	ok       bool
+	failed   bool
+	panicked any
+}
+
+// Parallel runs the following stages over the sequence with the given number of workers, or one per CPU if not positive
+func Parallel[T any](s Seq[T], workers int) ParallelSeq[T] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return ParallelSeq[T]{
+		tasks: func(yield func(func() (T, bool)) bool) {
+			for item := range s.iter {
+				if !yield(func() (T, bool) { return item, true }) {
+					return
+				}
+			}
+		},
+		workers: workers,
+	}
+}
+
+// Ordered makes the results come in the order of the source items, at the cost of holding back
+// results that finish early. In-flight items are bounded, so a slow item stalls the workers rather than memory.
+func (p ParallelSeq[T]) Ordered() ParallelSeq[T] {
+	p.ordered = true
+	return p
+}
+
+func ParallelMap[T, R any](p ParallelSeq[T], fn func(T) R) ParallelSeq[R] {
+	return ParallelSeq[R]{
+		tasks: func(yield func(func() (R, bool)) bool) {
+			for task := range p.tasks {
+				next := func() (R, bool) {
+					item, ok := task()
+					if !ok {
+						var zero R
+						return zero, false
+					}
+					return fn(item), true
+				}
+				if !yield(next) {
+					return
+				}
+			}
+		},
+		workers: p.workers,
+		ordered: p.ordered,
+	}
+}
+
+func ParallelFilter[T any](p ParallelSeq[T], fn func(T) bool) ParallelSeq[T] {
+	return ParallelSeq[T]{
+		tasks: func(yield func(func() (T, bool)) bool) {
+			for task := range p.tasks {
+				next := func() (T, bool) {
+					item, ok := task()
+					if !ok || !fn(item) {
+						var zero T
+						return zero, false
+					}
+					return item, true
+				}
+				if !yield(next) {
+					return
+				}
+			}
+		},
+		workers: p.workers,
+		ordered: p.ordered,
+	}
+}
+
+// ParallelForEach calls fn on every item from the workers, so calls happen concurrently and in no particular order
+func ParallelForEach[T any](p ParallelSeq[T], fn func(T)) {
+	for range ParallelMap(p, func(item T) struct{} {
+		fn(item)
+		return struct{}{}
+	}).Iter() {
+	}
+}
+
+// ParallelReduce folds the items into one value. Each worker folds the items it handles with accumulate, starting
+// from identity, and the partial results are merged with combine, so combine must be associative and commutative
+// with identity as its neutral element, like + with 0.
+// On an Ordered sequence the stages still run in parallel, but accumulate is applied in order from the calling
+// goroutine and combine is not used.
+func ParallelReduce[T, R any](p ParallelSeq[T], identity R, accumulate func(R, T) R, combine func(R, R) R) R {
+	if p.ordered {
+		result := identity
+		for item := range p.Iter() {
+			result = accumulate(result, item)
+		}
+		return result
+	}
+
+	partials := make(chan R, p.workers)
+	for range p.workers {
+		partials <- identity
+	}
+	ParallelForEach(p, func(item T) {
+		partials <- accumulate(<-partials, item)
+	})
+	close(partials)
+
+	result := identity
+	for partial := range partials {
+		result = combine(result, partial)
+	}
+	return result
+}
+
+// Sequential returns the results as an ordinary sequence, for the operations ParallelSeq does not have
+func (p ParallelSeq[T]) Sequential() Seq[T] {
+	return FromIter(p.Iter())
+}
+
+func (p ParallelSeq[T]) ToSlice() []T {
+	return p.Sequential().ToSlice()
+}
+
+// Iter runs the stages and iterates their results
+func (p ParallelSeq[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		done := make(chan struct{})
+		jobs := make(chan parallelJob[T])
+		results := make(chan parallelResult[T])
+		slots := make(chan struct{}, 2*p.workers)
+
+		var sourcePanic *parallelResult[T]
+		var source, workers sync.WaitGroup
+		source.Add(1)
+		go func() {
+			defer source.Done()
+			defer close(jobs)
+			defer func() {
+				if r := recover(); r != nil {
+					sourcePanic = &parallelResult[T]{failed: true, panicked: r}
+				}
+			}()
+			index := 0
+			for task := range p.tasks {
+				select {
+				case slots <- struct{}{}:
+				case <-done:
+					return
+				}
+				select {
+				case jobs <- parallelJob[T]{index: index, task: task}:
+				case <-done:
+					return
+				}
+				index++
+			}
+		}()
+
+		for range p.workers {
+			workers.Add(1)
+			go func() {
+				defer workers.Done()
+				for {
+					var job parallelJob[T]
+					var ok bool
+					select {
+					case job, ok = <-jobs:
+						if !ok {
+							return
+						}
+					case <-done:
+						return
+					}
+					select {
+					case results <- job.run():
+					case <-done:
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			workers.Wait()
+			close(results)
+		}()
+
+		// stop runs however the iteration ends, including a panic in the caller's loop body
+		var once sync.Once
+		stop := func() {
+			once.Do(func() {
+				close(done)
+				for range results {
+				}
+				source.Wait()
+			})
+		}
+		defer stop()
+
+		pending := map[int]parallelResult[T]{}
+		next := 0
+		for result := range results {
+			if result.failed {
+				panic(result.panicked)
+			}
+			if !p.ordered {
+				<-slots
+				if result.ok && !yield(result.item) {
+					return
+				}
+				continue
+			}
+
+			pending[result.index] = result
+			for {
+				ready, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				<-slots
+				if ready.ok && !yield(ready.item) {
+					return
+				}
+			}
+		}
+		stop()
+		if sourcePanic != nil {
+			panic(sourcePanic.panicked)
+		}
+	}
+}
+
+// run computes the job's result, capturing a panic instead of crashing the worker
+func (j parallelJob[T]) run() (result parallelResult[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parallelResult[T]{index: j.index, failed: true, panicked: r}
+		}
+	}()
+	item, ok := j.task()
+	return parallelResult[T]{index: j.index, item: item, ok: ok}
+}
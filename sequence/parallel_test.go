@@ -0,0 +1,189 @@
+package sequence_test
+
+import (
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/sequence"
+)
+
+func TestParallelMapAndFilter(t *testing.T) {
+	t.Run("unordered results hold every item", func(t *testing.T) {
+		squares := sequence.ParallelMap(sequence.Parallel(sequence.Range(0, 100, 1), 4), func(n int) int { return n * n })
+		got := squares.ToSlice()
+		slices.Sort(got)
+		assert.Equal(t, sequence.Map(sequence.Range(0, 100, 1), func(n int) int { return n * n }).ToSlice(), got)
+	})
+
+	t.Run("ordered results keep the input order", func(t *testing.T) {
+		// later items finish first, so only the reordering can put them back
+		slow := sequence.ParallelMap(sequence.Parallel(sequence.Range(0, 20, 1), 4).Ordered(), func(n int) string {
+			time.Sleep(time.Duration(20-n) * 100 * time.Microsecond)
+			return strconv.Itoa(n)
+		})
+		evens := sequence.ParallelFilter(slow, func(s string) bool { return s[len(s)-1]%2 == 0 })
+		assert.Equal(t, []string{"0", "2", "4", "6", "8", "10", "12", "14", "16", "18"}, evens.ToSlice())
+	})
+
+	t.Run("stages run concurrently", func(t *testing.T) {
+		var running, peak atomic.Int32
+		sequence.ParallelForEach(sequence.Parallel(sequence.Range(0, 16, 1), 4), func(int) {
+			current := running.Add(1)
+			for {
+				seen := peak.Load()
+				if current <= seen || peak.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		})
+		assert.True(t, peak.Load() > 1)
+		assert.True(t, peak.Load() <= 4)
+	})
+
+	t.Run("defaults to one worker per CPU", func(t *testing.T) {
+		assert.Equal(t, 10, len(sequence.Parallel(sequence.Range(0, 10, 1), 0).ToSlice()))
+	})
+
+	t.Run("empty source", func(t *testing.T) {
+		assert.Equal(t, []int{}, sequence.Parallel(sequence.Of[int](), 3).ToSlice())
+	})
+}
+
+func TestParallelEarlyTermination(t *testing.T) {
+	var calls atomic.Int32
+	doubled := sequence.ParallelMap(sequence.Parallel(sequence.Iterate(1, func(n int) int { return n + 1 }), 4).Ordered(), func(n int) int {
+		calls.Add(1)
+		return n * 2
+	})
+	assert.Equal(t, []int{2, 4, 6, 8, 10}, doubled.Sequential().Take(5).ToSlice())
+
+	// only the items already in flight run past the point where the consumer stopped
+	settled := calls.Load()
+	assert.True(t, settled < 5+2*4+4)
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, settled, calls.Load())
+}
+
+// assertNoGoroutinesLeft waits briefly for goroutines to exit, since one that has finished its work may not be gone yet
+func assertNoGoroutinesLeft(t *testing.T, before int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		runtime.Gosched()
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestParallelCleanup(t *testing.T) {
+	endless := func(pulls *atomic.Int32) sequence.ParallelSeq[int] {
+		source := sequence.Iterate(1, func(n int) int { return n + 1 }).OnEach(func(int) { pulls.Add(1) })
+		return sequence.ParallelMap(sequence.Parallel(source, 4), func(n int) int { return n * 2 })
+	}
+
+	t.Run("early break", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		var pulls atomic.Int32
+		for n := range endless(&pulls).Iter() {
+			if n > 10 {
+				break
+			}
+		}
+		// the source has stopped before Iter returned, so nothing pulls behind the caller's back
+		settled := pulls.Load()
+		time.Sleep(5 * time.Millisecond)
+		assert.Equal(t, settled, pulls.Load())
+		assertNoGoroutinesLeft(t, before)
+	})
+
+	t.Run("panic in the loop body", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		var pulls atomic.Int32
+		assert.Panics(t, func() {
+			for range endless(&pulls).Iter() {
+				panic("body")
+			}
+		})
+		settled := pulls.Load()
+		time.Sleep(5 * time.Millisecond)
+		assert.Equal(t, settled, pulls.Load())
+		assertNoGoroutinesLeft(t, before)
+	})
+
+	t.Run("panic in a stage", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		failing := sequence.ParallelMap(sequence.Parallel(sequence.Range(0, 100, 1), 4).Ordered(), func(n int) int {
+			if n == 50 {
+				panic("stage")
+			}
+			return n
+		})
+		assert.Panics(t, func() { failing.ToSlice() })
+		assertNoGoroutinesLeft(t, before)
+	})
+}
+
+func TestParallelPanics(t *testing.T) {
+	t.Run("from a stage", func(t *testing.T) {
+		failing := sequence.ParallelMap(sequence.Parallel(sequence.Range(0, 50, 1), 4), func(n int) int {
+			if n == 17 {
+				panic("bad item")
+			}
+			return n
+		})
+		assert.Panics(t, func() { failing.ToSlice() })
+	})
+
+	t.Run("from the source", func(t *testing.T) {
+		source := sequence.Range(0, 10, 1).OnEach(func(n int) {
+			if n == 5 {
+				panic("bad source")
+			}
+		})
+		assert.Panics(t, func() { sequence.Parallel(source, 2).ToSlice() })
+	})
+}
+
+func TestParallelForEach(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[int]bool{}
+	sequence.ParallelForEach(sequence.Parallel(sequence.Range(0, 50, 1), 8), func(n int) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen[n] = true
+	})
+	assert.Equal(t, 50, len(seen))
+}
+
+func TestParallelReduce(t *testing.T) {
+	t.Run("sum with an associative combiner", func(t *testing.T) {
+		p := sequence.Parallel(sequence.Range(1, 1001, 1), 4)
+		sum := sequence.ParallelReduce(p, 0, func(acc, n int) int { return acc + n }, func(a, b int) int { return a + b })
+		assert.Equal(t, 500500, sum)
+	})
+
+	t.Run("counts into another type", func(t *testing.T) {
+		words := sequence.Parallel(sequence.Of("go", "is", "fun", "and", "fast"), 3)
+		letters := sequence.ParallelReduce(words, 0, func(acc int, w string) int { return acc + len(w) }, func(a, b int) int { return a + b })
+		assert.Equal(t, 14, letters)
+	})
+
+	t.Run("ordered reduction keeps the order", func(t *testing.T) {
+		upper := sequence.ParallelMap(sequence.Parallel(sequence.Of("a", "b", "c", "d", "e"), 3).Ordered(), strings.ToUpper)
+		joined := sequence.ParallelReduce(upper, "", func(acc, s string) string { return acc + s }, func(a, b string) string { return a + b })
+		assert.Equal(t, "ABCDE", joined)
+	})
+
+	t.Run("empty source gives the identity", func(t *testing.T) {
+		p := sequence.Parallel(sequence.Of[int](), 4)
+		assert.Equal(t, 1, sequence.ParallelReduce(p, 1, func(acc, n int) int { return acc * n }, func(a, b int) int { return a * b }))
+	})
+}
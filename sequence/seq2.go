@@ -0,0 +1,190 @@
+package sequence
+
+import (
+	"iter"
+)
+
+// Seq2 is a lazy sequence of key-value pairs, wrapping iter.Seq2 so it ranges as `for k, v := range s.Iter()`.
+// Keys need not be unique.
+type Seq2[K, V any] struct {
+	iter iter.Seq2[K, V]
+}
+
+func FromIter2[K, V any](it iter.Seq2[K, V]) Seq2[K, V] {
+	return Seq2[K, V]{iter: it}
+}
+
+// FromMap returns the entries of the map, in the map's unspecified iteration order
+func FromMap[K comparable, V any](m map[K]V) Seq2[K, V] {
+	return Seq2[K, V]{
+		iter: func(yield func(K, V) bool) {
+			for key, value := range m {
+				if !yield(key, value) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// FromPairs turns a sequence of pairs into a sequence of key-value pairs
+func FromPairs[K, V any](s Seq[Pair[K, V]]) Seq2[K, V] {
+	return Seq2[K, V]{
+		iter: func(yield func(K, V) bool) {
+			for pair := range s.iter {
+				if !yield(pair.First, pair.Second) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Indexed pairs each item of the sequence with its index, like WithIndex but as a Seq2
+func Indexed[T any](s Seq[T]) Seq2[int, T] {
+	return Seq2[int, T]{
+		iter: func(yield func(int, T) bool) {
+			idx := 0
+			for item := range s.iter {
+				if !yield(idx, item) {
+					return
+				}
+				idx++
+			}
+		},
+	}
+}
+
+// KeyBy pairs each item of the sequence with the key computed from it
+func KeyBy[T, K any](s Seq[T], keyFn func(T) K) Seq2[K, T] {
+	return Seq2[K, T]{
+		iter: func(yield func(K, T) bool) {
+			for item := range s.iter {
+				if !yield(keyFn(item), item) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func (s Seq2[K, V]) Iter() iter.Seq2[K, V] {
+	return s.iter
+}
+
+func (s Seq2[K, V]) Filter(fn func(K, V) bool) Seq2[K, V] {
+	return Seq2[K, V]{
+		iter: func(yield func(K, V) bool) {
+			for key, value := range s.iter {
+				if fn(key, value) && !yield(key, value) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func (s Seq2[K, V]) Take(n int) Seq2[K, V] {
+	return Seq2[K, V]{
+		iter: func(yield func(K, V) bool) {
+			if n <= 0 {
+				return
+			}
+			count := 0
+			for key, value := range s.iter {
+				if !yield(key, value) {
+					return
+				}
+				count++
+				if count >= n {
+					return
+				}
+			}
+		},
+	}
+}
+
+func MapKeys[K, V, NK any](s Seq2[K, V], fn func(K, V) NK) Seq2[NK, V] {
+	return Seq2[NK, V]{
+		iter: func(yield func(NK, V) bool) {
+			for key, value := range s.iter {
+				if !yield(fn(key, value), value) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func MapValues[K, V, NV any](s Seq2[K, V], fn func(K, V) NV) Seq2[K, NV] {
+	return Seq2[K, NV]{
+		iter: func(yield func(K, NV) bool) {
+			for key, value := range s.iter {
+				if !yield(key, fn(key, value)) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func (s Seq2[K, V]) Keys() Seq[K] {
+	return Seq[K]{
+		iter: func(yield func(K) bool) {
+			for key := range s.iter {
+				if !yield(key) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func (s Seq2[K, V]) Values() Seq[V] {
+	return Seq[V]{
+		iter: func(yield func(V) bool) {
+			for _, value := range s.iter {
+				if !yield(value) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Pairs turns the sequence into a sequence of pairs
+func (s Seq2[K, V]) Pairs() Seq[Pair[K, V]] {
+	return Seq[Pair[K, V]]{
+		iter: func(yield func(Pair[K, V]) bool) {
+			for key, value := range s.iter {
+				if !yield(Pair[K, V]{First: key, Second: value}) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func (s Seq2[K, V]) ForEach(fn func(K, V)) {
+	for key, value := range s.iter {
+		fn(key, value)
+	}
+}
+
+func (s Seq2[K, V]) Count() int {
+	count := 0
+	for range s.iter {
+		count++
+	}
+	return count
+}
+
+// ToMap collects the pairs into a map, later values winning for repeated keys.
+// The result can be assigned to a collection.MutableMap.
+func ToMap[K comparable, V any](s Seq2[K, V]) map[K]V {
+	result := map[K]V{}
+	for key, value := range s.iter {
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package sequence_test
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/sequence"
+)
+
+func TestSeq2Sources(t *testing.T) {
+	t.Run("from iter.Seq2", func(t *testing.T) {
+		seq := sequence.FromIter2(slices.All([]string{"a", "b"}))
+		assert.Equal(t, []int{0, 1}, seq.Keys().ToSlice())
+		assert.Equal(t, []string{"a", "b"}, seq.Values().ToSlice())
+	})
+
+	t.Run("from a map", func(t *testing.T) {
+		m := map[string]int{"a": 1, "b": 2}
+		assert.Equal(t, m, sequence.ToMap(sequence.FromMap(m)))
+		assert.Equal(t, []string{"a", "b"}, slices.Sorted(sequence.FromMap(m).Keys().Iter()))
+	})
+
+	t.Run("from pairs", func(t *testing.T) {
+		pairs := sequence.Of(sequence.Pair[string, int]{First: "x", Second: 1}, sequence.Pair[string, int]{First: "y", Second: 2})
+		assert.Equal(t, map[string]int{"x": 1, "y": 2}, sequence.ToMap(sequence.FromPairs(pairs)))
+		assert.Equal(t, pairs.ToSlice(), sequence.FromPairs(pairs).Pairs().ToSlice())
+	})
+
+	t.Run("indexed", func(t *testing.T) {
+		var got []string
+		for i, word := range sequence.Indexed(sequence.Of("zero", "one", "two")).Iter() {
+			got = append(got, strings.Repeat("*", i)+word)
+		}
+		assert.Equal(t, []string{"zero", "*one", "**two"}, got)
+	})
+
+	t.Run("keyed", func(t *testing.T) {
+		byLength := sequence.ToMap(sequence.KeyBy(sequence.Of("go", "rust", "zig"), func(s string) int { return len(s) }))
+		assert.Equal(t, map[int]string{2: "go", 4: "rust", 3: "zig"}, byLength)
+	})
+}
+
+func TestSeq2Operations(t *testing.T) {
+	scores := sequence.FromIter2(maps.All(map[string]int{"ana": 90, "bob": 55, "cid": 72}))
+
+	t.Run("filter", func(t *testing.T) {
+		passed := scores.Filter(func(_ string, score int) bool { return score >= 60 })
+		assert.Equal(t, map[string]int{"ana": 90, "cid": 72}, sequence.ToMap(passed))
+		assert.Equal(t, 2, passed.Count())
+	})
+
+	t.Run("map keys and values", func(t *testing.T) {
+		upper := sequence.MapKeys(scores, func(name string, _ int) string { return strings.ToUpper(name) })
+		assert.Equal(t, map[string]int{"ANA": 90, "BOB": 55, "CID": 72}, sequence.ToMap(upper))
+
+		grades := sequence.MapValues(scores, func(_ string, score int) bool { return score >= 60 })
+		assert.Equal(t, map[string]bool{"ana": true, "bob": false, "cid": true}, sequence.ToMap(grades))
+	})
+
+	t.Run("take and for each", func(t *testing.T) {
+		seq := sequence.Indexed(sequence.Iterate(1, func(n int) int { return n * 3 }))
+		sum := 0
+		seq.Take(3).ForEach(func(i, n int) { sum += i * n })
+		assert.Equal(t, 0*1+1*3+2*9, sum)
+		assert.Equal(t, 0, seq.Take(0).Count())
+	})
+
+	t.Run("later values win in ToMap", func(t *testing.T) {
+		seq := sequence.KeyBy(sequence.Of(1, 2, 3, 4), func(n int) bool { return n%2 == 0 })
+		assert.Equal(t, map[bool]int{false: 3, true: 4}, sequence.ToMap(seq))
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		calls := 0
+		seq := sequence.MapValues(sequence.Indexed(sequence.Of(1, 2, 3)), func(_ int, n int) int {
+			calls++
+			return n
+		})
+		assert.Equal(t, 0, calls)
+		seq.Take(2).Count()
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -150,15 +150,18 @@ func Fold[T, R any](s Seq[T], initial R, fn func(R, T) R) R {
 func (s Seq[T]) Take(n int) Seq[T] {
 	return Seq[T]{
 		iter: func(yield func(T) bool) {
+			if n <= 0 {
+				return
+			}
 			count := 0
 			for item := range s.iter {
-				if count >= n {
-					return
-				}
 				if !yield(item) {
 					return
 				}
 				count++
+				if count >= n {
+					return
+				}
 			}
 		},
 	}
@@ -137,6 +137,14 @@ func TestSequenceTake(t *testing.T) {
 		result := seq.ToSlice()
 		assert.Equal(t, []int{}, result)
 	})
+
+	t.Run("does not pull past the nth element", func(t *testing.T) {
+		pulled := 0
+		seq := sequence.Of(1, 2, 3, 4, 5).OnEach(func(int) { pulled++ }).Take(2)
+		result := seq.ToSlice()
+		assert.Equal(t, []int{1, 2}, result)
+		assert.Equal(t, 2, pulled)
+	})
 }
 
 func TestSequenceTakeWhile(t *testing.T) {
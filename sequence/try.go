@@ -0,0 +1,172 @@
+package sequence
+
+import (
+	"iter"
+)
+
+// TrySeq is a lazy sequence whose stages can fail. The first error ends the sequence: later stages pass it
+// through without running, and terminal operations return it along with what was done before it.
+type TrySeq[T any] struct {
+	iter iter.Seq2[T, error]
+}
+
+// Try starts a fallible pipeline from a sequence that cannot fail itself
+func Try[T any](s Seq[T]) TrySeq[T] {
+	return TrySeq[T]{
+		iter: func(yield func(T, error) bool) {
+			for item := range s.iter {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// FromTryIter wraps an iterator of items and errors, ending it at the first error
+func FromTryIter[T any](it iter.Seq2[T, error]) TrySeq[T] {
+	return TrySeq[T]{
+		iter: func(yield func(T, error) bool) {
+			for item, err := range it {
+				if err != nil {
+					var zero T
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Iter ranges as `for item, err := range s.Iter()`, where a non-nil err is the last pair
+func (s TrySeq[T]) Iter() iter.Seq2[T, error] {
+	return s.iter
+}
+
+func TryMap[T, R any](s TrySeq[T], fn func(T) (R, error)) TrySeq[R] {
+	return TrySeq[R]{
+		iter: func(yield func(R, error) bool) {
+			var zero R
+			for item, err := range s.iter {
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				mapped, err := fn(item)
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(mapped, nil) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func TryFilter[T any](s TrySeq[T], fn func(T) (bool, error)) TrySeq[T] {
+	return TrySeq[T]{
+		iter: func(yield func(T, error) bool) {
+			var zero T
+			for item, err := range s.iter {
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				keep, err := fn(item)
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				if keep && !yield(item, nil) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func TryFlatMap[T, R any](s TrySeq[T], fn func(T) ([]R, error)) TrySeq[R] {
+	return TrySeq[R]{
+		iter: func(yield func(R, error) bool) {
+			var zero R
+			for item, err := range s.iter {
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				mapped, err := fn(item)
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				for _, r := range mapped {
+					if !yield(r, nil) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// Take stops after n items, so an error further on is never reached
+func (s TrySeq[T]) Take(n int) TrySeq[T] {
+	return TrySeq[T]{
+		iter: func(yield func(T, error) bool) {
+			if n <= 0 {
+				return
+			}
+			count := 0
+			for item, err := range s.iter {
+				if !yield(item, err) || err != nil {
+					return
+				}
+				count++
+				if count >= n {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ToSlice collects the items, returning the ones before the first error along with it
+func (s TrySeq[T]) ToSlice() ([]T, error) {
+	return TryFold(s, []T{}, func(acc []T, item T) []T {
+		return append(acc, item)
+	})
+}
+
+// ForEach calls fn on each item until the first error, which it returns
+func (s TrySeq[T]) ForEach(fn func(T)) error {
+	for item, err := range s.iter {
+		if err != nil {
+			return err
+		}
+		fn(item)
+	}
+	return nil
+}
+
+func (s TrySeq[T]) Count() (int, error) {
+	return TryFold(s, 0, func(count int, _ T) int {
+		return count + 1
+	})
+}
+
+// TryFold folds the items until the first error, returning the accumulator so far along with it
+func TryFold[T, R any](s TrySeq[T], initial R, fn func(R, T) R) (R, error) {
+	acc := initial
+	for item, err := range s.iter {
+		if err != nil {
+			return acc, err
+		}
+		acc = fn(acc, item)
+	}
+	return acc, nil
+}
@@ -0,0 +1,162 @@
+package sequence_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	assert "github.com/marlonbarreto-git/gollections/internal/testing"
+	"github.com/marlonbarreto-git/gollections/sequence"
+)
+
+var errBadRecord = errors.New("bad record")
+
+func TestTryMap(t *testing.T) {
+	t.Run("maps every item", func(t *testing.T) {
+		numbers, err := sequence.TryMap(sequence.Try(sequence.Of("1", "22", "333")), strconv.Atoi).ToSlice()
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 22, 333}, numbers)
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		calls := 0
+		numbers, err := sequence.TryMap(sequence.Try(sequence.Of("1", "2", "x", "4", "y")), func(s string) (int, error) {
+			calls++
+			return strconv.Atoi(s)
+		}).ToSlice()
+		var numErr *strconv.NumError
+		assert.True(t, errors.As(err, &numErr))
+		assert.Equal(t, "x", numErr.Num)
+		assert.Equal(t, []int{1, 2}, numbers)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("later stages do not run after an error", func(t *testing.T) {
+		parsed := sequence.TryMap(sequence.Try(sequence.Of("1", "bad", "3")), strconv.Atoi)
+		seen := 0
+		doubled := sequence.TryMap(parsed, func(n int) (int, error) {
+			seen++
+			return n * 2, nil
+		})
+		items, err := doubled.ToSlice()
+		assert.Error(t, err)
+		assert.Equal(t, []int{2}, items)
+		assert.Equal(t, 1, seen)
+	})
+}
+
+func TestTryFilter(t *testing.T) {
+	evens := func(n int) (bool, error) {
+		if n < 0 {
+			return false, fmt.Errorf("negative: %d", n)
+		}
+		return n%2 == 0, nil
+	}
+
+	items, err := sequence.TryFilter(sequence.Try(sequence.Range(0, 7, 1)), evens).ToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 2, 4, 6}, items)
+
+	items, err = sequence.TryFilter(sequence.Try(sequence.Of(2, 3, -1, 4)), evens).ToSlice()
+	assert.Equal(t, "negative: -1", err.Error())
+	assert.Equal(t, []int{2}, items)
+}
+
+func TestTryFlatMap(t *testing.T) {
+	split := func(line string) ([]string, error) {
+		if line == "" {
+			return nil, errors.New("empty line")
+		}
+		return strings.Fields(line), nil
+	}
+
+	words, err := sequence.TryFlatMap(sequence.Try(sequence.Of("a b", "c")), split).ToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, words)
+
+	count, err := sequence.TryFlatMap(sequence.Try(sequence.Of("a b", "", "c")), split).Count()
+	assert.Equal(t, "empty line", err.Error())
+	assert.Equal(t, 2, count)
+}
+
+func TestTrySources(t *testing.T) {
+	t.Run("from an iterator ends at its first error", func(t *testing.T) {
+		pulls := 0
+		source := sequence.FromTryIter(func(yield func(int, error) bool) {
+			for n := 1; n <= 5; n++ {
+				pulls++
+				var err error
+				if n%2 == 0 {
+					err = errBadRecord
+				}
+				if !yield(n, err) {
+					return
+				}
+			}
+		})
+		items, err := source.ToSlice()
+		assert.ErrorIs(t, err, errBadRecord)
+		assert.Equal(t, []int{1}, items)
+		assert.Equal(t, 2, pulls)
+	})
+
+	t.Run("ranges over items and errors", func(t *testing.T) {
+		var got []string
+		for n, err := range sequence.TryMap(sequence.Try(sequence.Of("7", "?")), strconv.Atoi).Iter() {
+			if err != nil {
+				got = append(got, "error")
+				continue
+			}
+			got = append(got, strconv.Itoa(n))
+		}
+		assert.Equal(t, []string{"7", "error"}, got)
+	})
+}
+
+func TestTryTerminals(t *testing.T) {
+	parse := func(input ...string) sequence.TrySeq[int] {
+		return sequence.TryMap(sequence.Try(sequence.Of(input...)), strconv.Atoi)
+	}
+
+	t.Run("take never reaches a later error", func(t *testing.T) {
+		items, err := parse("1", "2", "oops").Take(2).ToSlice()
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, items)
+
+		items, err = parse("1", "oops").Take(0).ToSlice()
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, items)
+	})
+
+	t.Run("take still reports an earlier error", func(t *testing.T) {
+		_, err := parse("oops", "1").Take(5).ToSlice()
+		assert.Error(t, err)
+	})
+
+	t.Run("for each", func(t *testing.T) {
+		sum := 0
+		assert.NoError(t, parse("1", "2", "3").ForEach(func(n int) { sum += n }))
+		assert.Equal(t, 6, sum)
+
+		assert.Error(t, parse("4", "x").ForEach(func(n int) { sum += n }))
+		assert.Equal(t, 10, sum)
+	})
+
+	t.Run("fold", func(t *testing.T) {
+		product, err := sequence.TryFold(parse("2", "3", "4"), 1, func(acc, n int) int { return acc * n })
+		assert.NoError(t, err)
+		assert.Equal(t, 24, product)
+
+		product, err = sequence.TryFold(parse("2", "3", "four"), 1, func(acc, n int) int { return acc * n })
+		assert.Error(t, err)
+		assert.Equal(t, 6, product)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		count, err := parse().Count()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
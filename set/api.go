@@ -5,7 +5,9 @@ import (
 	"github.com/marlonbarreto-git/gollections/tomove/types"
 )
 
-// Api represents a set data structure
+// Api represents a set data structure.
+// Implementations backed by a plain map, such as collection.Set, are not safe for concurrent use;
+// collection.ConcurrentSet is.
 type Api[K comparable] interface {
 
 	// Contains checks if the set contains the given item